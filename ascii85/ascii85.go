@@ -0,0 +1,377 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ascii85 implements the Ascii85 encoding used by btoa and
+// PostScript/PDF, and the Z85 encoding used by ZeroMQ, with the same
+// API shape as base64x.Encoding.
+package ascii85
+
+import (
+    `encoding/ascii85`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a radix 85 encoding/decoding scheme, which encodes
+// every 4 bytes into 5 characters.
+type Encoding int
+
+const (
+    _MODE_Z85 = 1 << 0
+)
+
+// StdEncoding is the Ascii85 encoding, as produced by encoding/ascii85.
+// An all-zero group of 4 bytes is encoded as a single 'z', and the
+// "<~" and "~>" delimiters are not part of the encoding.
+const StdEncoding Encoding = 0
+
+// Z85Encoding is the ZeroMQ Z85 encoding, defined in ZeroMQ RFC 32.
+// It uses an alphabet that is safe to embed in source code and has no
+// zero-group shortcut.
+//
+// RFC 32 requires the input to be a multiple of 4 bytes. Other lengths
+// are handled like Ascii85, by encoding the last partial group with
+// fewer characters.
+const Z85Encoding Encoding = _MODE_Z85
+
+// CorruptInputError is the error returned for malformed input. It is
+// the same type as in encoding/ascii85.
+type CorruptInputError = ascii85.CorruptInputError
+
+const (
+    _ID_SKIP = 0xfd
+    _ID_ZERO = 0xfe
+    _ID_NONE = 0xff
+)
+
+const (
+    charsetZ85 = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+)
+
+var (
+    charsetStd     = makeCharsetStd()
+    decodeTableStd = makeDecodeTable(charsetStd, true)
+    decodeTableZ85 = makeDecodeTable(charsetZ85, false)
+)
+
+func makeCharsetStd() string {
+    var buf [85]byte
+    for i := range buf {
+        buf[i] = byte('!' + i)
+    }
+    return string(buf[:])
+}
+
+func makeDecodeTable(charset string, zero bool) (tab [256]byte) {
+    for i := range tab {
+        if i <= ' ' {
+            tab[i] = _ID_SKIP
+        } else {
+            tab[i] = _ID_NONE
+        }
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    if zero {
+        tab['z'] = _ID_ZERO
+    }
+    return
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing at most
+// MaxEncodedLen(len(src)) bytes to out, and returns the number of
+// bytes written.
+//
+// The last partial group is encoded with fewer characters, so Encode
+// is not appropriate for use on individual blocks of a large data
+// stream. Use NewEncoder instead.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) int {
+    if len(src) == 0 {
+        return 0
+    } else if buf := out[:0:len(out)]; self.MaxEncodedLen(len(src)) <= len(out) {
+        self.EncodeUnsafe(&buf, src)
+        return len(buf)
+    } else {
+        panic("encoder output buffer is too small")
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    nb := len(*out)
+    op := 0
+    st := charsetStd
+    buf := (*out)[nb:cap(*out)]
+
+    /* check for Z85 alphabet */
+    if (self & _MODE_Z85) != 0 {
+        st = charsetZ85
+    }
+
+    /* encode every 4 bytes into 5 characters */
+    for len(src) >= 4 {
+        v := uint32(src[0]) << 24 | uint32(src[1]) << 16 | uint32(src[2]) << 8 | uint32(src[3])
+        src = src[4:]
+
+        /* zero group shortcut */
+        if v == 0 && (self & _MODE_Z85) == 0 {
+            buf[op] = 'z'
+            op++
+            continue
+        }
+
+        /* the divisions by constants are strength-reduced into multiplications */
+        _ = buf[op + 4]
+        buf[op + 4] = st[v % 85]; v /= 85
+        buf[op + 3] = st[v % 85]; v /= 85
+        buf[op + 2] = st[v % 85]; v /= 85
+        buf[op + 1] = st[v % 85]; v /= 85
+        buf[op + 0] = st[v]
+        op += 5
+    }
+
+    /* encode the last partial group, as if it was padded with zeros */
+    if len(src) != 0 {
+        var v uint32
+        var ch [5]byte
+
+        /* load the remaining bytes */
+        for i := 0; i < 4; i++ {
+            v <<= 8
+            if i < len(src) {
+                v |= uint32(src[i])
+            }
+        }
+
+        /* convert the group, and keep only len(src) + 1 characters */
+        for i := 4; i >= 0; i-- {
+            ch[i] = st[v % 85]
+            v /= 85
+        }
+        op += copy(buf[op:], ch[:len(src) + 1])
+    }
+
+    /* update the output length */
+    *out = (*out)[:nb + op]
+}
+
+// EncodeToString returns the radix 85 encoding of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.MaxEncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// MaxEncodedLen returns the maximum length in bytes of the encoding
+// of an input buffer of length n.
+func (self Encoding) MaxEncodedLen(n int) int {
+    return (n + 3) / 4 * 5
+}
+
+/** Decoder Functions **/
+
+// Decode decodes src using the encoding enc. It writes at most
+// MaxDecodedLen(len(src)) bytes to out and returns the number of bytes
+// written. If src contains invalid data, it will return 0 and
+// CorruptInputError.
+//
+// Whitespace and control characters are ignored. The last partial
+// group is decoded as if it was padded, like encoding/ascii85 does
+// when flushing.
+//
+// If out is not large enough to contain the decoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.MaxDecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    nb := len(*out)
+    buf := (*out)[nb:cap(*out)]
+
+    /* decode everything, including the last partial group */
+    if nd, _, err := self.decode(buf, src, true); err != nil {
+        return 0, err
+    } else {
+        *out = (*out)[:nb + nd]
+        return nd, nil
+    }
+}
+
+// DecodeString returns the bytes represented by the radix 85 string s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.decodedLen(src))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// MaxDecodedLen returns the maximum length in bytes of the decoded data
+// corresponding to n bytes of encoded data. For StdEncoding, every 'z'
+// may decode into 4 bytes.
+func (self Encoding) MaxDecodedLen(n int) int {
+    if (self & _MODE_Z85) == 0 {
+        return n * 4
+    } else {
+        return (n + 4) / 5 * 4
+    }
+}
+
+// decodedLen returns the maximum length of the decoded data of src,
+// which is usually much tighter than MaxDecodedLen.
+func (self Encoding) decodedLen(src []byte) int {
+    nz := 0
+    if (self & _MODE_Z85) == 0 {
+        for _, c := range src {
+            if c == 'z' {
+                nz++
+            }
+        }
+    }
+    return (len(src) - nz + 4) / 5 * 4 + nz * 4
+}
+
+// decode decodes src into out, and returns the number of bytes written
+// and consumed. Without flush, the last partial group is not consumed,
+// and it stops if out cannot hold another group.
+func (self Encoding) decode(out []byte, src []byte, flush bool) (nd int, ns int, err error) {
+    nb := 0
+    st := &decodeTableStd
+    v0 := uint64(0)
+
+    /* check for Z85 alphabet */
+    if (self & _MODE_Z85) != 0 {
+        st = &decodeTableZ85
+    }
+
+    /* decode every 5 characters into 4 bytes */
+    for i := 0; i < len(src); i++ {
+        if !flush && len(out) - nd < 4 {
+            return
+        }
+
+        /* 5 characters without whitespace, take the fast path */
+        if nb == 0 && i + 5 <= len(src) {
+            c0 := st[src[i + 0]]
+            c1 := st[src[i + 1]]
+            c2 := st[src[i + 2]]
+            c3 := st[src[i + 3]]
+            c4 := st[src[i + 4]]
+
+            /* all characters are digits */
+            if c0 < 85 && c1 < 85 && c2 < 85 && c3 < 85 && c4 < 85 {
+                vv := (((uint64(c0) * 85 + uint64(c1)) * 85 + uint64(c2)) * 85 + uint64(c3)) * 85 + uint64(c4)
+                if vv > 0xffffffff {
+                    return 0, 0, CorruptInputError(i + 4)
+                }
+                out[nd + 0] = byte(vv >> 24)
+                out[nd + 1] = byte(vv >> 16)
+                out[nd + 2] = byte(vv >> 8)
+                out[nd + 3] = byte(vv)
+                nd += 4
+                i += 4
+                ns = i + 1
+                continue
+            }
+        }
+
+        /* check for special characters */
+        switch id := st[src[i]]; {
+            case id < 85                   : v0 = v0 * 85 + uint64(id); nb++
+            case id == _ID_ZERO && nb == 0 : v0 = 0; nb = 5
+            case id != _ID_SKIP            : return 0, 0, CorruptInputError(i)
+        }
+
+        /* consume the whitespaces between groups */
+        if nb == 0 {
+            ns = i + 1
+            continue
+        }
+
+        /* the group is not yet completed */
+        if nb != 5 {
+            continue
+        }
+
+        /* check for overflows */
+        if v0 > 0xffffffff {
+            return 0, 0, CorruptInputError(i)
+        }
+
+        /* store the group */
+        out[nd + 0] = byte(v0 >> 24)
+        out[nd + 1] = byte(v0 >> 16)
+        out[nd + 2] = byte(v0 >> 8)
+        out[nd + 3] = byte(v0)
+        nd += 4
+        ns = i + 1
+        nb = 0
+        v0 = 0
+    }
+
+    /* keep the partial group for the next call */
+    if !flush {
+        return
+    }
+
+    /* a single character cannot represent any bytes */
+    if ns = len(src); nb == 1 {
+        return 0, 0, CorruptInputError(len(src))
+    }
+
+    /* decode the last partial group, as if it was padded with the largest digit */
+    if nb != 0 {
+        for i := nb; i < 5; i++ {
+            v0 = v0 * 85 + 84
+        }
+        if v0 > 0xffffffff {
+            return 0, 0, CorruptInputError(len(src))
+        }
+        for i := 0; i < nb - 1; i++ {
+            out[nd] = byte(v0 >> 24)
+            v0 <<= 8
+            nd++
+        }
+    }
+    return
+}
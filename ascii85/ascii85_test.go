@@ -0,0 +1,163 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ascii85
+
+import (
+    `bytes`
+    `encoding/ascii85`
+    `math/rand`
+    `testing`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+var pairs = []TestPair{
+    // encoding/ascii85 examples
+    {"", ""},
+    {"\000\000\000\000", "z"},
+    {"\000", "!!"},
+    {"\000\000\000\000\000", "z!!"},
+    {"hello", "BOu!rDZ"},
+
+    // Wikipedia example
+    {
+        "Man is distinguished, not only by his reason, but by this singular passion from " +
+        "other animals, which is a lust of the mind, that by a perseverance of delight in " +
+        "the continued and indefatigable generation of knowledge, exceeds the short " +
+        "vehemence of any carnal pleasure.",
+        "9jqo^BlbD-BleB1DJ+*+F(f,q/0JhKF<GL>Cj@.4Gp$d7F!,L7@<6@)/0JDEF<G%<+EV:2F!,O<" +
+        "DJ+*.@<*K0@<6L(Df-\\0Ec5e;DffZ(EZee.Bl.9pF\"AGXBPCsi+DGm>@3BB/F*&OCAfu2/AKY" +
+        "i(DIb:@FD,*)+C]U=@3BN#EcYf8ATD3s@q?d$AftVqCh[NqF<G:8+EV:.+Cf>-FD5W8ARlolDIa" +
+        "l(DId<j@<?3r@:F%a+D58'ATD4$Bl@l3De:,-DJs`8ARoFb/0JMK@qB4^F!,R<AKZ&-DfTqBG%G" +
+        ">uD.RTpAKYo'+CT/5+Cei#DII?(E,9)oF*2M7/c",
+    },
+}
+
+var z85Pairs = []TestPair{
+    // ZeroMQ RFC 32 examples
+    {"", ""},
+    {"\x86\x4f\xd2\x6f\xb5\x59\xf7\x5b", "HelloWorld"},
+    {
+        "\x8e\x0b\xdd\x69\x76\x28\xb9\x1d\x8f\x24\x55\x87\xee\x95\xc5\xb0" +
+        "\x4d\x48\x96\x3f\x79\x25\x98\x77\xb4\x9c\xd9\x06\x3a\xea\xd3\xb7",
+        "JTKVSB%%)wK0E.X)V>+}o?pNmC{O&4W4b!Ni{Lh6",
+    },
+    {"\x00\x00\x00\x00", "00000"},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestEncoder(t *testing.T) {
+    for _, p := range pairs {
+        got := StdEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, p.encoded)
+    }
+    for _, p := range z85Pairs {
+        got := Z85Encoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, p.encoded)
+    }
+}
+
+func TestDecoder(t *testing.T) {
+    for _, tt := range []struct {
+        enc   Encoding
+        pairs []TestPair
+    }{
+        {StdEncoding, pairs},
+        {Z85Encoding, z85Pairs},
+    } {
+        for _, p := range tt.pairs {
+            dbuf := make([]byte, tt.enc.MaxDecodedLen(len(p.encoded)))
+            count, err := tt.enc.Decode(dbuf, []byte(p.encoded))
+            testEqual(t, "Decode(%q) = error %v, want %v", p.encoded, err, error(nil))
+            testEqual(t, "Decode(%q) = %q, want %q", p.encoded, string(dbuf[:count]), p.decoded)
+
+            /* whitespaces are ignored */
+            spaced := " \n" + string(bytes.Join(bytes.SplitAfter([]byte(p.encoded), nil), []byte{'\t'}))
+            dbuf, err = tt.enc.DecodeString(spaced)
+            testEqual(t, "DecodeString(%q) = error %v, want %v", spaced, err, error(nil))
+            testEqual(t, "DecodeString(%q) = %q, want %q", spaced, string(dbuf), p.decoded)
+        }
+    }
+}
+
+func TestDecoderError(t *testing.T) {
+    tests := []struct {
+        enc Encoding
+        src string
+        pos int
+    }{
+        {StdEncoding, "BOu!rD", 6},
+        {StdEncoding, "BOu!rDZv", 7},
+        {StdEncoding, "BOzu!", 2},
+        {StdEncoding, "uuuuu", 4},
+        {StdEncoding, "s8W-\"", 4},
+        {StdEncoding, "z\nB", 3},
+        {Z85Encoding, "Hello~orld", 5},
+        {Z85Encoding, "%%%%%", 4},
+        {Z85Encoding, "Hel\"o", 3},
+    }
+    for _, tt := range tests {
+        _, err := tt.enc.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(CorruptInputError(tt.pos)))
+    }
+}
+
+func TestStdlibCompatible(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 256; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* make some zero groups */
+        if n % 3 == 0 && n >= 8 {
+            copy(src[4:8], "\x00\x00\x00\x00")
+        }
+
+        /* encode must be identical */
+        buf := make([]byte, ascii85.MaxEncodedLen(n))
+        want := string(buf[:ascii85.Encode(buf, src)])
+        got := StdEncoding.EncodeToString(src)
+        testEqual(t, "EncodeToString(%x) = %q, want %q", src, got, want)
+
+        /* decode must round-trip */
+        dec, err := StdEncoding.DecodeString(want)
+        if err != nil || !bytes.Equal(dec, src) {
+            t.Errorf("DecodeString(%q) = %x, %v, want %x", want, dec, err, src)
+        }
+
+        /* Z85 must round-trip too */
+        enc := Z85Encoding.EncodeToString(src)
+        if n % 4 == 0 {
+            testEqual(t, "EncodedLen(%d) = %d, want %d", n, len(enc), Z85Encoding.MaxEncodedLen(n))
+        }
+        dec, err = Z85Encoding.DecodeString(enc)
+        if err != nil || !bytes.Equal(dec, src) {
+            t.Errorf("DecodeString(%q) = %x, %v, want %x", enc, dec, err, src)
+        }
+    }
+}
@@ -0,0 +1,195 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ascii85
+
+import (
+    `io`
+)
+
+const (
+    _STREAM_BUF = 1024
+)
+
+type encoder struct {
+    enc  Encoding
+    err  error
+    w    io.Writer
+    buf  [4]byte
+    nbuf int
+    out  [_STREAM_BUF]byte
+}
+
+// NewEncoder returns a new encoder. Data written to the returned writer
+// will be encoded using enc and then written to w. The last partial
+// group is only written when the writer is closed.
+func NewEncoder(enc Encoding, w io.Writer) io.WriteCloser {
+    return &encoder{enc: enc, w: w}
+}
+
+func (self *encoder) Write(p []byte) (n int, err error) {
+    if self.err != nil {
+        return 0, self.err
+    }
+
+    /* complete the buffered group first */
+    if self.nbuf > 0 {
+        i := copy(self.buf[self.nbuf:], p)
+        n += i
+        p = p[i:]
+        if self.nbuf += i; self.nbuf < 4 {
+            return
+        }
+        if self.err = self.flush(self.buf[:]); self.err != nil {
+            return n, self.err
+        }
+        self.nbuf = 0
+    }
+
+    /* encode whole groups, as many as the output buffer can hold */
+    for len(p) >= 4 {
+        nb := len(self.out) / 5 * 4
+        if nb > len(p) {
+            nb = len(p)
+        }
+        nb -= nb % 4
+        if self.err = self.flush(p[:nb]); self.err != nil {
+            return n, self.err
+        }
+        n += nb
+        p = p[nb:]
+    }
+
+    /* buffer the remaining bytes */
+    self.nbuf = copy(self.buf[:], p)
+    n += self.nbuf
+    return
+}
+
+// Close flushes any pending output from the encoder.
+// It is an error to call Write after calling Close.
+func (self *encoder) Close() error {
+    if self.err == nil && self.nbuf > 0 {
+        self.err = self.flush(self.buf[:self.nbuf])
+        self.nbuf = 0
+    }
+    return self.err
+}
+
+func (self *encoder) flush(src []byte) error {
+    buf := self.out[:0]
+    self.enc.EncodeUnsafe(&buf, src)
+    _, err := self.w.Write(buf)
+    return err
+}
+
+type decoder struct {
+    enc  Encoding
+    err  error
+    rerr error
+    r    io.Reader
+    off  int64
+    buf  [_STREAM_BUF]byte
+    nbuf int
+    out  []byte
+    obuf [_STREAM_BUF * 4]byte
+}
+
+// NewDecoder constructs a new decoder, which decodes the data read
+// from r using enc. The offset of a CorruptInputError is relative to
+// the beginning of the stream.
+func NewDecoder(enc Encoding, r io.Reader) io.Reader {
+    return &decoder{enc: enc, r: r}
+}
+
+func (self *decoder) Read(p []byte) (n int, err error) {
+    if len(p) == 0 {
+        return 0, nil
+    }
+
+    /* copy out the pending decoded data, if any */
+    for {
+        if len(self.out) > 0 {
+            n = copy(p, self.out)
+            self.out = self.out[n:]
+            return
+        }
+
+        /* sticky errors */
+        if self.err != nil {
+            return 0, self.err
+        }
+
+        /* decode what we have, flushing the partial group on EOF */
+        if self.nbuf > 0 || self.rerr != nil {
+            nd, ns, err := self.enc.decode(self.obuf[:], self.buf[:self.nbuf], self.rerr != nil)
+            if err != nil {
+                self.err = err.(CorruptInputError) + CorruptInputError(self.off)
+                return 0, self.err
+            }
+
+            /* shift the unconsumed input */
+            self.off += int64(ns)
+            self.out = self.obuf[:nd]
+            self.nbuf = copy(self.buf[:], self.buf[ns:self.nbuf])
+
+            /* copy out the decoded data */
+            if nd > 0 {
+                continue
+            }
+
+            /* end of input */
+            if self.rerr != nil {
+                self.err = self.rerr
+                return 0, self.err
+            }
+
+            /* the buffer is filled with a partial group and whitespaces */
+            if self.nbuf == len(self.buf) {
+                self.compact()
+            }
+        }
+
+        /* read more input */
+        nb, err := self.r.Read(self.buf[self.nbuf:])
+        self.nbuf += nb
+        self.rerr = err
+    }
+}
+
+// compact removes the whitespaces from the buffered input. All of
+// them precede the unread input, so the stream offset stays exact.
+func (self *decoder) compact() {
+    nb := 0
+    st := &decodeTableStd
+
+    /* check for Z85 alphabet */
+    if (self.enc & _MODE_Z85) != 0 {
+        st = &decodeTableZ85
+    }
+
+    /* keep only the non-whitespace characters */
+    for _, c := range self.buf[:self.nbuf] {
+        if st[c] != _ID_SKIP {
+            self.buf[nb] = c
+            nb++
+        }
+    }
+
+    /* account for the removed characters */
+    self.off += int64(self.nbuf - nb)
+    self.nbuf = nb
+}
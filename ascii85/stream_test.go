@@ -0,0 +1,106 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ascii85
+
+import (
+    `bytes`
+    `encoding/ascii85`
+    `io`
+    `math/rand`
+    `strings`
+    `testing`
+    `testing/iotest`
+)
+
+func TestEncoderStream(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    src := make([]byte, 10000)
+    rng.Read(src)
+    copy(src[400:], make([]byte, 100))
+
+    /* write in chunks of every size */
+    for _, enc := range []Encoding{StdEncoding, Z85Encoding} {
+        want := enc.EncodeToString(src)
+        for _, bs := range []int{1, 2, 3, 4, 5, 7, 1023, 5000} {
+            buf := new(bytes.Buffer)
+            w := NewEncoder(enc, buf)
+            for p := src; len(p) > 0; {
+                n := bs
+                if n > len(p) {
+                    n = len(p)
+                }
+                if _, err := w.Write(p[:n]); err != nil {
+                    t.Fatal(err)
+                }
+                p = p[n:]
+            }
+            if err := w.Close(); err != nil {
+                t.Fatal(err)
+            }
+            testEqual(t, "NewEncoder(%d, %d) = %q, want %q", enc, bs, buf.String(), want)
+        }
+    }
+}
+
+func TestDecoderStream(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    src := make([]byte, 10000)
+    rng.Read(src)
+    copy(src[400:], make([]byte, 100))
+
+    /* compare with encoding/ascii85 */
+    want, err := io.ReadAll(ascii85.NewDecoder(strings.NewReader(StdEncoding.EncodeToString(src))))
+    if err != nil || !bytes.Equal(want, src) {
+        t.Fatalf("ascii85.NewDecoder() = %v", err)
+    }
+
+    /* read in one byte at a time, with whitespaces */
+    for _, enc := range []Encoding{StdEncoding, Z85Encoding} {
+        encoded := enc.EncodeToString(src)
+        for _, in := range []string{encoded, strings.Join(strings.SplitAfter(encoded, ""), "\n")} {
+            dec, err := io.ReadAll(NewDecoder(enc, iotest.OneByteReader(strings.NewReader(in))))
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("NewDecoder(%d) = %v", enc, err)
+            }
+            dec, err = io.ReadAll(iotest.OneByteReader(NewDecoder(enc, strings.NewReader(in))))
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("NewDecoder(%d) = %v", enc, err)
+            }
+        }
+    }
+}
+
+func TestDecoderStreamWhitespaces(t *testing.T) {
+    in := "BO" + strings.Repeat(" ", 3000) + "u!rDZ" + strings.Repeat("\n", 2000)
+    dec, err := io.ReadAll(NewDecoder(StdEncoding, strings.NewReader(in)))
+    testEqual(t, "NewDecoder() = error %v, want %v", err, error(nil))
+    testEqual(t, "NewDecoder() = %q, want %q", string(dec), "hello")
+}
+
+func TestDecoderStreamError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        pos int
+    }{
+        {strings.Repeat("z", 3000) + "~", 3000},
+        {strings.Repeat("z", 3000) + "B", 3001},
+        {"BO" + strings.Repeat(" ", 3000) + "u~rDZ", 3003},
+    } {
+        _, err := io.Copy(io.Discard, NewDecoder(StdEncoding, strings.NewReader(tt.src)))
+        testEqual(t, "NewDecoder() = error %v, want %v", err, error(CorruptInputError(tt.pos)))
+    }
+}
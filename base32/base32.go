@@ -0,0 +1,406 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package base32 implements the base32 encodings defined in RFC 4648
+// with the same API shape as base64x.Encoding.
+//
+// Inputs longer than a few groups are handled by the native kernels in
+// native/base32.h, which encode 20 bytes and decode 32 characters at a
+// time with AVX2. Shorter ones are handled in Go, since the cost of
+// calling into the native code dominates for them; see the Base32 cases
+// in bench/.
+package base32
+
+import (
+    `encoding/base32`
+    `encoding/binary`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a radix 32 encoding/decoding scheme, defined by a
+// 32-character alphabet. RFC 4648 defines the standard alphabet and
+// the "Extended Hex Alphabet", which preserves the sort order of the
+// encoded data.
+type Encoding int
+
+const (
+    _MODE_HEX = 1 << 0
+    _MODE_RAW = 1 << 1
+)
+
+// StdEncoding is the standard base32 encoding, as defined in
+// RFC 4648.
+const StdEncoding Encoding = 0
+
+// HexEncoding is the "Extended Hex Alphabet" defined in RFC 4648.
+// It is typically used in DNS.
+const HexEncoding Encoding = _MODE_HEX
+
+// RawStdEncoding is the standard raw, unpadded base32 encoding.
+//
+// This is the same as StdEncoding but omits padding characters.
+const RawStdEncoding Encoding = _MODE_RAW
+
+// RawHexEncoding is the unpadded "Extended Hex Alphabet" encoding.
+//
+// This is the same as HexEncoding but omits padding characters.
+const RawHexEncoding Encoding = _MODE_RAW | _MODE_HEX
+
+// CorruptInputError is the error returned for malformed base32 input.
+// It is the same type as in encoding/base32, so errors from either
+// package can be handled the same way.
+type CorruptInputError = base32.CorruptInputError
+
+// Inputs up to these sizes are handled in Go. The thresholds are chosen
+// by BenchmarkSmall, which compares the Go versions with the native
+// calls alone.
+const (
+    _SMALL_ENCODE = 10
+    _SMALL_DECODE = 16
+)
+
+const (
+    charsetStd = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+    charsetHex = "0123456789ABCDEFGHIJKLMNOPQRSTUV"
+)
+
+var (
+    encodeTableStd = makeEncodeTable(charsetStd)
+    encodeTableHex = makeEncodeTable(charsetHex)
+    decodeTableStd = makeDecodeTable(charsetStd)
+    decodeTableHex = makeDecodeTable(charsetHex)
+)
+
+// makeEncodeTable makes the table of the 2 characters of every 10 bits,
+// in little-endian order, so that 4 of them make a uint64.
+func makeEncodeTable(charset string) (tab [1024]uint16) {
+    for i := range tab {
+        tab[i] = uint16(charset[i >> 5]) | uint16(charset[i & 0x1f]) << 8
+    }
+    return
+}
+
+func makeDecodeTable(charset string) (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    return
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing
+// EncodedLen(len(src)) bytes to out.
+//
+// The encoding pads the output to a multiple of 8 bytes,
+// so Encode is not appropriate for use on individual blocks
+// of a large data stream.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) {
+    if len(src) != 0 {
+        if buf := out[:0:len(out)]; self.EncodedLen(len(src)) <= len(out) {
+            self.EncodeUnsafe(&buf, src)
+        } else {
+            panic("encoder output buffer is too small")
+        }
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    if len(src) <= _SMALL_ENCODE {
+        self.encodeSmall(out, src)
+    } else {
+        native.B32Encode(out, &src, int(self))
+    }
+}
+
+// encodeSmall is the Go version of the native encoder.
+func (self Encoding) encodeSmall(out *[]byte, src []byte) {
+    nb := len(*out)
+    st := charsetStd
+    et := &encodeTableStd
+    ret := (*out)[:nb + self.EncodedLen(len(src))]
+    buf := ret[nb:]
+
+    /* check for the hex alphabet */
+    if (self & _MODE_HEX) != 0 {
+        st = charsetHex
+        et = &encodeTableHex
+    }
+
+    /* encode every 5 bytes into 8 characters, 2 characters at a time */
+    for len(src) >= 5 && len(buf) >= 8 {
+        v := uint64(src[0]) << 32 | uint64(src[1]) << 24 | uint64(src[2]) << 16 | uint64(src[3]) << 8 | uint64(src[4])
+        w := uint64(et[(v >> 30) & 0x3ff]) | uint64(et[(v >> 20) & 0x3ff]) << 16 | uint64(et[(v >> 10) & 0x3ff]) << 32 | uint64(et[v & 0x3ff]) << 48
+        binary.LittleEndian.PutUint64(buf, w)
+        src = src[5:]
+        buf = buf[8:]
+    }
+
+    /* encode the last few bytes */
+    if len(src) != 0 {
+        var v uint64
+        for i := 0; i < 5; i++ {
+            v <<= 8
+            if i < len(src) {
+                v |= uint64(src[i])
+            }
+        }
+
+        /* each byte produces 8/5 characters, rounded up */
+        nc := (len(src) * 8 + 4) / 5
+        for i := 0; i < nc; i++ {
+            buf[i] = st[(v >> uint(35 - i * 5)) & 0x1f]
+        }
+
+        /* pad if needed */
+        for i := nc; i < len(buf); i++ {
+            buf[i] = '='
+        }
+    }
+
+    /* update the output length */
+    *out = ret
+}
+
+// EncodeToString returns the base32 encoding of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.EncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// EncodedLen returns the length in bytes of the base32 encoding
+// of an input buffer of length n.
+func (self Encoding) EncodedLen(n int) int {
+    if (self & _MODE_RAW) == 0 {
+        return (n + 4) / 5 * 8
+    } else {
+        return (n * 8 + 4) / 5
+    }
+}
+
+/** Decoder Functions **/
+
+// Decode decodes src using the encoding enc. It writes at most
+// DecodedLen(len(src)) bytes to out and returns the number of bytes
+// written. If src contains invalid base32 data, it will return the
+// number of bytes successfully written and CorruptInputError.
+//
+// New line characters (\r and \n) are ignored. Unlike encoding/base32,
+// the offset in CorruptInputError counts the ignored characters too.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.DecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    if len(src) <= _SMALL_DECODE {
+        return self.decodeSmall(out, src)
+    }
+
+    /* decode in native code */
+    if n := native.B32Decode(out, unsafe.Pointer(&src[0]), len(src), int(self)); n >= 0 {
+        return n, nil
+    } else {
+        return 0, CorruptInputError(-n - 1)
+    }
+}
+
+// decodeSmall is the Go version of the native decoder, with the same
+// error offsets.
+func (self Encoding) decodeSmall(out *[]byte, src []byte) (int, error) {
+    nb := len(*out)
+    ip := 0
+    op := 0
+    st := &decodeTableStd
+    buf := (*out)[nb:cap(*out)]
+
+    /* check for the hex alphabet */
+    if (self & _MODE_HEX) != 0 {
+        st = &decodeTableHex
+    }
+
+    /* decode every 8 characters into 5 bytes */
+    for ip < len(src) {
+        if ip + 8 <= len(src) && op + 5 <= len(buf) {
+            v0 := st[src[ip + 0]]
+            v1 := st[src[ip + 1]]
+            v2 := st[src[ip + 2]]
+            v3 := st[src[ip + 3]]
+            v4 := st[src[ip + 4]]
+            v5 := st[src[ip + 5]]
+            v6 := st[src[ip + 6]]
+            v7 := st[src[ip + 7]]
+
+            /* all characters are valid, take the fast path */
+            if (v0 | v1 | v2 | v3 | v4 | v5 | v6 | v7) != 0xff {
+                buf[op + 0] = v0 << 3 | v1 >> 2
+                buf[op + 1] = v1 << 6 | v2 << 1 | v3 >> 4
+                buf[op + 2] = v3 << 4 | v4 >> 1
+                buf[op + 3] = v4 << 7 | v5 << 2 | v6 >> 3
+                buf[op + 4] = v6 << 5 | v7
+                ip += 8
+                op += 5
+                continue
+            }
+        }
+
+        /* slow path for newlines, paddings, errors and the last block */
+        if nc, ep := self.decodeBlock(buf[op:], src, &ip, st); ep >= 0 {
+            return 0, CorruptInputError(ep)
+        } else {
+            op += nc
+        }
+    }
+
+    /* update the output length */
+    *out = (*out)[:nb + op]
+    return op, nil
+}
+
+// decodeBlock decodes up to 8 characters starting at *ipp, skipping
+// newlines. It returns the number of decoded bytes and -1, or the
+// error position.
+func (self Encoding) decodeBlock(out []byte, src []byte, ipp *int, st *[256]byte) (int, int) {
+    nb := 0
+    ip := *ipp
+    pad := false
+    v0 := uint64(0)
+
+    /* load up to 8 characters */
+    for ip < len(src) && nb < 8 && !pad {
+        ch := src[ip]
+        ip++
+
+        /* skip new lines */
+        if ch == '\r' || ch == '\n' {
+            continue
+        }
+
+        /* lookup the index, and check for invalid characters */
+        if id := st[ch]; id != 0xff {
+            v0 = v0 << 5 | uint64(id)
+            nb++
+            continue
+        }
+
+        /* must be the padding */
+        if (self & _MODE_RAW) != 0 || ch != '=' || !validTail(nb) {
+            return 0, ip - 1
+        }
+
+        /* the remaining characters must be exactly 8 - nb paddings */
+        np := 1
+        for ; ip < len(src); ip++ {
+            if ch = src[ip]; ch == '\r' || ch == '\n' {
+                continue
+            } else if ch != '=' || np == 8 - nb {
+                return 0, ip
+            } else {
+                np++
+            }
+        }
+
+        /* not enough paddings */
+        if np != 8 - nb {
+            return 0, ip
+        }
+
+        /* all the paddings are consumed */
+        pad = true
+    }
+
+    /* only newlines */
+    if nb == 0 {
+        *ipp = ip
+        return 0, -1
+    }
+
+    /* eof without paddings, MODE_STD needs paddings */
+    if nb != 8 && !pad {
+        if (self & _MODE_RAW) == 0 || !validTail(nb) {
+            return 0, ip
+        }
+    }
+
+    /* decode into output */
+    nc := nb * 5 / 8
+    v0 <<= uint(5 * (8 - nb))
+    for i := 0; i < nc; i++ {
+        out[i] = byte(v0 >> uint(32 - i * 8))
+    }
+
+    /* update the pointers */
+    *ipp = ip
+    return nc, -1
+}
+
+// validTail checks if the last block with nb characters can be
+// decoded into whole bytes.
+func validTail(nb int) bool {
+    return nb == 2 || nb == 4 || nb == 5 || nb == 7
+}
+
+// DecodeString returns the bytes represented by the base32 string s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.DecodedLen(len(s)))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// DecodedLen returns the maximum length in bytes of the decoded data
+// corresponding to n bytes of base32-encoded data.
+func (self Encoding) DecodedLen(n int) int {
+    if (self & _MODE_RAW) == 0 {
+        return n / 8 * 5
+    } else {
+        return n * 5 / 8
+    }
+}
@@ -0,0 +1,334 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base32
+
+import (
+    `bytes`
+    `encoding/base32`
+    `math/rand`
+    `strings`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/native/sse`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+var pairs = []TestPair{
+    // RFC 4648 examples
+    {"", ""},
+    {"f", "MY======"},
+    {"fo", "MZXQ===="},
+    {"foo", "MZXW6==="},
+    {"foob", "MZXW6YQ="},
+    {"fooba", "MZXW6YTB"},
+    {"foobar", "MZXW6YTBOI======"},
+
+    // Wikipedia examples, converted to base32
+    {"sure.", "ON2XEZJO"},
+    {"sure", "ON2XEZI="},
+    {"sur", "ON2XE==="},
+    {"su", "ON2Q===="},
+    {"leasure.", "NRSWC43VOJSS4==="},
+    {"easure.", "MVQXG5LSMUXA===="},
+    {"asure.", "MFZXK4TFFY======"},
+
+    // Relatively long strings
+    {
+        "Twas brillig, and the slithy toves",
+        "KR3WC4ZAMJZGS3DMNFTSYIDBNZSCA5DIMUQHG3DJORUHSIDUN53GK4Y=",
+    },
+}
+
+var hexPairs = []TestPair{
+    // RFC 4648 examples
+    {"", ""},
+    {"f", "CO======"},
+    {"fo", "CPNG===="},
+    {"foo", "CPNMU==="},
+    {"foob", "CPNMUOG="},
+    {"fooba", "CPNMUOJ1"},
+    {"foobar", "CPNMUOJ1E8======"},
+}
+
+type EncodingTest struct {
+    enc   Encoding
+    std   *base32.Encoding
+    pairs []TestPair
+    conv  func(string) string
+}
+
+func stdRef(ref string) string {
+    return ref
+}
+
+func rawRef(ref string) string {
+    return strings.ReplaceAll(ref, "=", "")
+}
+
+var encodingTests = []EncodingTest{
+    {StdEncoding, base32.StdEncoding, pairs, stdRef},
+    {HexEncoding, base32.HexEncoding, hexPairs, stdRef},
+    {RawStdEncoding, base32.StdEncoding.WithPadding(base32.NoPadding), pairs, rawRef},
+    {RawHexEncoding, base32.HexEncoding.WithPadding(base32.NoPadding), hexPairs, rawRef},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestEncoder(t *testing.T) {
+    for _, tt := range encodingTests {
+        for _, p := range tt.pairs {
+            got := tt.enc.EncodeToString([]byte(p.decoded))
+            testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, tt.conv(p.encoded))
+            testEqual(t, "EncodedLen(%d) = %d, want %d", len(p.decoded), tt.enc.EncodedLen(len(p.decoded)), len(got))
+        }
+    }
+}
+
+func TestDecoder(t *testing.T) {
+    for _, tt := range encodingTests {
+        for _, p := range tt.pairs {
+            encoded := tt.conv(p.encoded)
+            dbuf := make([]byte, tt.enc.DecodedLen(len(encoded)))
+            count, err := tt.enc.Decode(dbuf, []byte(encoded))
+            testEqual(t, "Decode(%q) = error %v, want %v", encoded, err, error(nil))
+            testEqual(t, "Decode(%q) = length %v, want %v", encoded, count, len(p.decoded))
+            testEqual(t, "Decode(%q) = %q, want %q", encoded, string(dbuf[0:count]), p.decoded)
+
+            dbuf, err = tt.enc.DecodeString(encoded)
+            testEqual(t, "DecodeString(%q) = error %v, want %v", encoded, err, error(nil))
+            testEqual(t, "DecodeString(%q) = %q, want %q", encoded, string(dbuf), p.decoded)
+        }
+    }
+}
+
+func TestDecoderCRLF(t *testing.T) {
+    for _, tt := range encodingTests {
+        for _, p := range tt.pairs {
+            encoded := tt.conv(p.encoded)
+            for i := 0; i <= len(encoded); i++ {
+                crlf := encoded[:i] + "\r\n" + encoded[i:]
+                dbuf, err := tt.enc.DecodeString(crlf)
+                testEqual(t, "DecodeString(%q) = error %v, want %v", crlf, err, error(nil))
+                testEqual(t, "DecodeString(%q) = %q, want %q", crlf, string(dbuf), p.decoded)
+            }
+        }
+    }
+}
+
+func TestDecoderError(t *testing.T) {
+    tests := []struct {
+        enc Encoding
+        src string
+        pos int
+    }{
+        {StdEncoding, "!NZSCA5D", 0},
+        {StdEncoding, "MZXW6YTB!ZXW6YTB", 8},
+        {StdEncoding, "MZXW6YT", 7},
+        {StdEncoding, "MZXW6YQ", 7},
+        {StdEncoding, "MY=====", 7},
+        {StdEncoding, "MY=======", 8},
+        {StdEncoding, "MY====A=", 6},
+        {StdEncoding, "M=======", 1},
+        {StdEncoding, "MZX=====", 3},
+        {StdEncoding, "MZXW6Y==", 6},
+        {StdEncoding, "MZXW6YQ=MZXW6YQ=", 8},
+        {StdEncoding, "mzxw6ytb", 0},
+        {HexEncoding, "CPNMUOJW", 7},
+        {RawStdEncoding, "MY======", 2},
+        {RawStdEncoding, "M", 1},
+        {RawStdEncoding, "MZX", 3},
+        {RawStdEncoding, "MZXW6Y", 6},
+        {RawStdEncoding, "MZXW6YTB\r\nM", 11},
+    }
+    for _, tt := range tests {
+        _, err := tt.enc.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(CorruptInputError(tt.pos)))
+    }
+}
+
+func TestStdlibCompatible(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, tt := range encodingTests {
+        for n := 0; n < 256; n++ {
+            src := make([]byte, n)
+            rng.Read(src)
+
+            /* encode must be identical */
+            got := tt.enc.EncodeToString(src)
+            want := tt.std.EncodeToString(src)
+            testEqual(t, "EncodeToString(%x) = %q, want %q", src, got, want)
+
+            /* decode must round-trip */
+            dec, err := tt.enc.DecodeString(want)
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("DecodeString(%q) = %x, %v, want %x", want, dec, err, src)
+            }
+        }
+    }
+}
+
+func TestDecoderShortBuffer(t *testing.T) {
+    defer func() {
+        if recover() == nil {
+            t.Fatal("Decode with short buffer did not panic")
+        }
+    }()
+    StdEncoding.Decode(make([]byte, 4), []byte("MZXW6YTB"))
+}
+
+type kernel struct {
+    name   string
+    encode func(out *[]byte, src *[]byte, mode int)
+    decode func(out *[]byte, src unsafe.Pointer, len int, mode int) int
+}
+
+// kernels returns the kernels selected for this CPU, and the SSE ones,
+// which are loaded if needed.
+func kernels() []kernel {
+    if sse.S_b32encode == 0 {
+        sse.Use()
+    }
+    return []kernel{
+        {"native", native.B32Encode, native.B32Decode},
+        {"sse", sse.B32encode, sse.B32decode},
+    }
+}
+
+func TestEncodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, tt := range encodingTests {
+        for n := 0; n <= 128; n++ {
+            for i := 0; i < 20; i++ {
+                src := make([]byte, n)
+                rng.Read(src)
+
+                /* compare with the native encoders */
+                got := append([]byte("prefix"), make([]byte, tt.enc.EncodedLen(n))...)[:6]
+                tt.enc.encodeSmall(&got, src)
+                for _, k := range kernels() {
+                    want := append([]byte("prefix"), make([]byte, tt.enc.EncodedLen(n))...)[:6]
+                    k.encode(&want, &src, int(tt.enc))
+                    testEqual(t, "encodeSmall(%x) = %q, want %q (" + k.name + ")", src, string(got), string(want))
+                }
+            }
+        }
+    }
+}
+
+func TestDecodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZ27az09UV=\r\n!"
+    for _, tt := range encodingTests {
+        for n := 1; n <= 128; n++ {
+            for i := 0; i < 200; i++ {
+                buf := make([]byte, rng.Intn(n * 5 / 8 + 1))
+                rng.Read(buf)
+                src := []byte(tt.enc.EncodeToString(buf))
+
+                /* break some of the inputs */
+                for j := rng.Intn(4); j > 0 && len(src) != 0 && i % 2 == 0; j-- {
+                    src[rng.Intn(len(src))] = alphabet[rng.Intn(len(alphabet))]
+                }
+                if len(src) == 0 {
+                    continue
+                }
+
+                /* compare with the native decoders */
+                got := make([]byte, 6, 6 + len(src))
+                gn, gerr := tt.enc.decodeSmall(&got, src)
+                for _, k := range kernels() {
+                    want := make([]byte, 6, 6 + len(src))
+                    if wn := k.decode(&want, unsafe.Pointer(&src[0]), len(src), int(tt.enc)); wn < 0 {
+                        testEqual(t, "decodeSmall(%q) = error %v, want %v (" + k.name + ")", src, gerr, error(CorruptInputError(-wn - 1)))
+                    } else {
+                        testEqual(t, "decodeSmall(%q) = error %v, want %v (" + k.name + ")", src, gerr, error(nil))
+                        testEqual(t, "decodeSmall(%q) = %d, want %d (" + k.name + ")", src, gn, wn)
+                        testEqual(t, "decodeSmall(%q) = %q, want %q (" + k.name + ")", src, string(got), string(want))
+                    }
+                }
+            }
+        }
+    }
+}
+
+func benchmarkEncodeSmall(b *testing.B, n int, asm bool) {
+    src := make([]byte, n)
+    buf := make([]byte, 0, StdEncoding.EncodedLen(n))
+    rand.New(rand.NewSource(0)).Read(src)
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.B32Encode(&out, &src, int(StdEncoding))
+        } else {
+            StdEncoding.encodeSmall(&out, src)
+        }
+    }
+}
+
+func benchmarkDecodeSmall(b *testing.B, n int, asm bool) {
+    raw := make([]byte, n / 8 * 5)
+    buf := make([]byte, 0, len(raw))
+    rand.New(rand.NewSource(0)).Read(raw)
+    src := []byte(StdEncoding.EncodeToString(raw))
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.B32Decode(&out, unsafe.Pointer(&src[0]), len(src), int(StdEncoding))
+        } else {
+            _, _ = StdEncoding.decodeSmall(&out, src)
+        }
+    }
+}
+
+func BenchmarkEncodeSmallGo_5B      (b *testing.B) { benchmarkEncodeSmall(b, 5, false) }
+func BenchmarkEncodeSmallGo_10B     (b *testing.B) { benchmarkEncodeSmall(b, 10, false) }
+func BenchmarkEncodeSmallGo_15B     (b *testing.B) { benchmarkEncodeSmall(b, 15, false) }
+func BenchmarkEncodeSmallGo_20B     (b *testing.B) { benchmarkEncodeSmall(b, 20, false) }
+func BenchmarkEncodeSmallGo_40B     (b *testing.B) { benchmarkEncodeSmall(b, 40, false) }
+func BenchmarkEncodeSmallNative_5B  (b *testing.B) { benchmarkEncodeSmall(b, 5, true) }
+func BenchmarkEncodeSmallNative_10B (b *testing.B) { benchmarkEncodeSmall(b, 10, true) }
+func BenchmarkEncodeSmallNative_15B (b *testing.B) { benchmarkEncodeSmall(b, 15, true) }
+func BenchmarkEncodeSmallNative_20B (b *testing.B) { benchmarkEncodeSmall(b, 20, true) }
+func BenchmarkEncodeSmallNative_40B (b *testing.B) { benchmarkEncodeSmall(b, 40, true) }
+
+func BenchmarkDecodeSmallGo_8B      (b *testing.B) { benchmarkDecodeSmall(b, 8, false) }
+func BenchmarkDecodeSmallGo_16B     (b *testing.B) { benchmarkDecodeSmall(b, 16, false) }
+func BenchmarkDecodeSmallGo_24B     (b *testing.B) { benchmarkDecodeSmall(b, 24, false) }
+func BenchmarkDecodeSmallGo_32B     (b *testing.B) { benchmarkDecodeSmall(b, 32, false) }
+func BenchmarkDecodeSmallGo_64B     (b *testing.B) { benchmarkDecodeSmall(b, 64, false) }
+func BenchmarkDecodeSmallNative_8B  (b *testing.B) { benchmarkDecodeSmall(b, 8, true) }
+func BenchmarkDecodeSmallNative_16B (b *testing.B) { benchmarkDecodeSmall(b, 16, true) }
+func BenchmarkDecodeSmallNative_24B (b *testing.B) { benchmarkDecodeSmall(b, 24, true) }
+func BenchmarkDecodeSmallNative_32B (b *testing.B) { benchmarkDecodeSmall(b, 32, true) }
+func BenchmarkDecodeSmallNative_64B (b *testing.B) { benchmarkDecodeSmall(b, 64, true) }
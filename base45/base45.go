@@ -0,0 +1,237 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package base45 implements the Base45 encoding defined in RFC 9285,
+// which is used in QR codes, with the same API shape as
+// base64x.Encoding.
+package base45
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a radix 45 encoding/decoding scheme, which encodes
+// every 2 bytes into 3 characters of the QR code alphanumeric set.
+type Encoding int
+
+// StdEncoding is the Base45 encoding, as defined in RFC 9285.
+const StdEncoding Encoding = 0
+
+// CorruptInputError is the error returned for malformed base45 input.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+    return "illegal base45 data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+const (
+    charsetStd = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ $%*+-./:"
+)
+
+var (
+    decodeTableStd = makeDecodeTable(charsetStd)
+)
+
+func makeDecodeTable(charset string) (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    return
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing
+// EncodedLen(len(src)) bytes to out.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) {
+    if len(src) != 0 {
+        if buf := out[:0:len(out)]; self.EncodedLen(len(src)) <= len(out) {
+            self.EncodeUnsafe(&buf, src)
+        } else {
+            panic("encoder output buffer is too small")
+        }
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    nb := len(*out)
+    st := charsetStd
+    ret := (*out)[:nb + self.EncodedLen(len(src))]
+    buf := ret[nb:]
+
+    /* encode every 2 bytes into 3 characters, least significant first */
+    for len(src) >= 2 && len(buf) >= 3 {
+        v := uint(src[0]) << 8 | uint(src[1])
+        buf[0] = st[v % 45]
+        buf[1] = st[v / 45 % 45]
+        buf[2] = st[v / 2025]
+        src = src[2:]
+        buf = buf[3:]
+    }
+
+    /* the last byte is encoded into 2 characters */
+    if len(src) != 0 {
+        v := uint(src[0])
+        buf[0] = st[v % 45]
+        buf[1] = st[v / 45]
+    }
+
+    /* update the output length */
+    *out = ret
+}
+
+// EncodeToString returns the base45 encoding of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.EncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// EncodedLen returns the length in bytes of the base45 encoding
+// of an input buffer of length n.
+func (self Encoding) EncodedLen(n int) int {
+    return n / 2 * 3 + n % 2 * 2
+}
+
+/** Decoder Functions **/
+
+// Decode decodes src using the encoding enc. It writes at most
+// DecodedLen(len(src)) bytes to out and returns the number of bytes
+// written. If src contains invalid base45 data, it will return 0 and
+// CorruptInputError.
+//
+// The offset in CorruptInputError is the invalid character, or the
+// first character of a group that exceeds its range. A dangling
+// character at the end is reported at its own offset.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.DecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    ip := 0
+    op := 0
+    nb := len(*out)
+    st := &decodeTableStd
+    buf := (*out)[nb:cap(*out)]
+
+    /* decode every 3 characters into 2 bytes */
+    for ip + 3 <= len(src) {
+        c0 := st[src[ip + 0]]
+        c1 := st[src[ip + 1]]
+        c2 := st[src[ip + 2]]
+
+        /* check for invalid characters */
+        if (c0 | c1 | c2) == 0xff {
+            return 0, CorruptInputError(ip + invalidAt(c0, c1, c2))
+        }
+
+        /* check for overflows */
+        v := uint(c0) + uint(c1) * 45 + uint(c2) * 2025
+        if v > 0xffff {
+            return 0, CorruptInputError(ip)
+        }
+
+        /* store the result */
+        buf[op + 0] = byte(v >> 8)
+        buf[op + 1] = byte(v)
+        ip += 3
+        op += 2
+    }
+
+    /* decode the last 2 characters into 1 byte */
+    switch len(src) - ip {
+        case 0: break
+        case 1: return 0, CorruptInputError(ip)
+        case 2: {
+            c0 := st[src[ip + 0]]
+            c1 := st[src[ip + 1]]
+
+            /* check for invalid characters */
+            if (c0 | c1) == 0xff {
+                return 0, CorruptInputError(ip + invalidAt(c0, c1, 0))
+            }
+
+            /* check for overflows */
+            v := uint(c0) + uint(c1) * 45
+            if v > 0xff {
+                return 0, CorruptInputError(ip)
+            }
+
+            /* store the result */
+            buf[op] = byte(v)
+            op++
+        }
+    }
+
+    /* update the output length */
+    *out = (*out)[:nb + op]
+    return op, nil
+}
+
+func invalidAt(c0 byte, c1 byte, c2 byte) int {
+    switch {
+        case c0 == 0xff : return 0
+        case c1 == 0xff : return 1
+        default         : return 2
+    }
+}
+
+// DecodeString returns the bytes represented by the base45 string s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.DecodedLen(len(s)))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// DecodedLen returns the maximum length in bytes of the decoded data
+// corresponding to n bytes of base45-encoded data.
+func (self Encoding) DecodedLen(n int) int {
+    return n / 3 * 2 + n % 3 / 2
+}
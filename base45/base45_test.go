@@ -0,0 +1,109 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base45
+
+import (
+    `bytes`
+    `math/rand`
+    `testing`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+var pairs = []TestPair{
+    // RFC 9285 examples
+    {"AB", "BB8"},
+    {"Hello!!", "%69 VD92EX0"},
+    {"base-45", "UJCLQE7W581"},
+    {"ietf!", "QED8WEX0"},
+
+    // edge cases
+    {"", ""},
+    {"\x00", "00"},
+    {"\xff", "U5"},
+    {"\x00\x00", "000"},
+    {"\xff\xff", "FGW"},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestEncoder(t *testing.T) {
+    for _, p := range pairs {
+        got := StdEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, p.encoded)
+    }
+}
+
+func TestDecoder(t *testing.T) {
+    for _, p := range pairs {
+        dbuf := make([]byte, StdEncoding.DecodedLen(len(p.encoded)))
+        count, err := StdEncoding.Decode(dbuf, []byte(p.encoded))
+        testEqual(t, "Decode(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "Decode(%q) = length %v, want %v", p.encoded, count, len(p.decoded))
+        testEqual(t, "Decode(%q) = %q, want %q", p.encoded, string(dbuf[:count]), p.decoded)
+
+        dbuf, err = StdEncoding.DecodeString(p.encoded)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "DecodeString(%q) = %q, want %q", p.encoded, string(dbuf), p.decoded)
+    }
+}
+
+func TestDecoderError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        pos int
+    }{
+        // RFC 9285 section 4.3 and 6
+        {"GGW", 0},
+        {"BB8GGW", 3},
+        {"BB8a", 3},
+        {"BB8B", 3},
+        {"V5", 0},
+        {"BB8V5", 3},
+        {"BB!", 2},
+        {"B!8", 1},
+        {"BB8B!", 4},
+    } {
+        _, err := StdEncoding.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(CorruptInputError(tt.pos)))
+    }
+}
+
+func TestRoundTrip(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 256; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+        enc := StdEncoding.EncodeToString(src)
+        testEqual(t, "EncodedLen(%d) = %d, want %d", n, StdEncoding.EncodedLen(n), len(enc))
+        testEqual(t, "DecodedLen(%d) = %d, want %d", len(enc), StdEncoding.DecodedLen(len(enc)), n)
+        dec, err := StdEncoding.DecodeString(enc)
+        if err != nil || !bytes.Equal(dec, src) {
+            t.Errorf("DecodeString(%q) = %x, %v, want %x", enc, dec, err, src)
+        }
+    }
+}
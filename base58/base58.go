@@ -0,0 +1,329 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package base58 implements the base58 encodings used by Bitcoin, IPFS,
+// Flickr and Ripple, with the same API shape as base64x.Encoding.
+package base58
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a radix 58 encoding/decoding scheme, defined by a
+// 58-character alphabet. The input is treated as a big-endian number,
+// with every leading zero byte encoded as the first character of the
+// alphabet.
+type Encoding int
+
+const (
+    _ALPHA_BITCOIN = 0
+    _ALPHA_FLICKR  = 1
+    _ALPHA_RIPPLE  = 2
+)
+
+// StdEncoding is the Bitcoin alphabet, which is also used by IPFS.
+const StdEncoding Encoding = _ALPHA_BITCOIN
+
+// FlickrEncoding is the alphabet used by Flickr short URLs.
+const FlickrEncoding Encoding = _ALPHA_FLICKR
+
+// RippleEncoding is the alphabet used by Ripple addresses.
+const RippleEncoding Encoding = _ALPHA_RIPPLE
+
+// CorruptInputError is the error returned for a character that is not
+// part of the alphabet.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+    return "illegal base58 data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+const (
+    _LIMB_DIGITS = 5
+    _LIMB_RADIX  = 58 * 58 * 58 * 58 * 58
+)
+
+var charsets = [...]string {
+    _ALPHA_BITCOIN : "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+    _ALPHA_FLICKR  : "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ",
+    _ALPHA_RIPPLE  : "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz",
+}
+
+var decodeTables = [...][256]byte {
+    _ALPHA_BITCOIN : makeDecodeTable(charsets[_ALPHA_BITCOIN]),
+    _ALPHA_FLICKR  : makeDecodeTable(charsets[_ALPHA_FLICKR]),
+    _ALPHA_RIPPLE  : makeDecodeTable(charsets[_ALPHA_RIPPLE]),
+}
+
+func makeDecodeTable(charset string) (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    return
+}
+
+// pow58 returns 58 to the power of n, with n <= _LIMB_DIGITS.
+func pow58(n int) uint64 {
+    v := uint64(1)
+    for i := 0; i < n; i++ {
+        v *= 58
+    }
+    return v
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing at most
+// MaxEncodedLen(len(src)) bytes to out, and returns the number of
+// bytes written.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) int {
+    if len(src) == 0 {
+        return 0
+    } else if buf := out[:0:len(out)]; self.MaxEncodedLen(len(src)) <= len(out) {
+        self.EncodeUnsafe(&buf, src)
+        return len(buf)
+    } else {
+        panic("encoder output buffer is too small")
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+//
+// The input is converted 32 bits at a time into limbs of 5 base58
+// digits, instead of one byte and one digit at a time.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    nz := 0
+    st := charsets[self]
+
+    /* count the leading zeros */
+    for nz < len(src) && src[nz] == 0 {
+        nz++
+    }
+
+    /* each limb holds 5 digits, each of which holds log2(58) bits */
+    nb := len(src) - nz
+    ls := make([]uint32, 0, (nb * 138 / 100 + _LIMB_DIGITS) / _LIMB_DIGITS + 1)
+
+    /* the first word may be partial */
+    for ip := nz; ip < len(src); {
+        nw := (len(src) - ip) % 4
+        if nw == 0 {
+            nw = 4
+        }
+
+        /* load the next big-endian word */
+        cv := uint64(0)
+        for i := 0; i < nw; i++ {
+            cv = cv << 8 | uint64(src[ip + i])
+        }
+
+        /* multiply the limbs by 2^(8 * nw), and add the word */
+        sh := uint(nw * 8)
+        for i := range ls {
+            cv += uint64(ls[i]) << sh
+            ls[i] = uint32(cv % _LIMB_RADIX)
+            cv /= _LIMB_RADIX
+        }
+
+        /* propagate the carry into new limbs */
+        for cv != 0 {
+            ls = append(ls, uint32(cv % _LIMB_RADIX))
+            cv /= _LIMB_RADIX
+        }
+        ip += nw
+    }
+
+    /* the leading zeros, then the limbs from the most significant one */
+    nd := len(*out)
+    ret := (*out)[:nd + nz + len(ls) * _LIMB_DIGITS]
+    buf := ret[nd:]
+
+    /* encode the leading zeros */
+    for i := 0; i < nz; i++ {
+        buf[i] = st[0]
+    }
+
+    /* convert every limb into 5 digits */
+    op := nz
+    for i := len(ls) - 1; i >= 0; i-- {
+        lv := ls[i]
+        for j := _LIMB_DIGITS - 1; j >= 0; j-- {
+            buf[op + j] = st[lv % 58]
+            lv /= 58
+        }
+        op += _LIMB_DIGITS
+    }
+
+    /* the most significant limb may have leading zero digits */
+    nl := nz
+    for nl < len(buf) && buf[nl] == st[0] {
+        nl++
+    }
+
+    /* strip them, and update the output length */
+    copy(buf[nz:], buf[nl:])
+    *out = ret[:len(ret) - (nl - nz)]
+}
+
+// EncodeToString returns the base58 encoding of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.MaxEncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// MaxEncodedLen returns the maximum length in bytes of the base58
+// encoding of an input buffer of length n.
+func (self Encoding) MaxEncodedLen(n int) int {
+    return n * 138 / 100 + _LIMB_DIGITS
+}
+
+/** Decoder Functions **/
+
+// Decode decodes src using the encoding enc. It writes at most
+// MaxDecodedLen(len(src)) bytes to out and returns the number of bytes
+// written. If src contains invalid base58 data, it will return 0 and
+// CorruptInputError.
+//
+// If out is not large enough to contain the decoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.MaxDecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+//
+// The input is converted 5 digits at a time into 32-bit limbs,
+// instead of one digit and one byte at a time.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    nz := 0
+    st := &decodeTables[self]
+
+    /* count the leading zeros */
+    for nz < len(src) && src[nz] == charsets[self][0] {
+        nz++
+    }
+
+    /* each digit holds log2(58) bits */
+    nb := len(src) - nz
+    ls := make([]uint32, 0, nb * 733 / 1000 / 4 + 2)
+
+    /* the first group may be partial */
+    for ip := nz; ip < len(src); {
+        nw := (len(src) - ip) % _LIMB_DIGITS
+        if nw == 0 {
+            nw = _LIMB_DIGITS
+        }
+
+        /* load the next group of digits */
+        cv := uint64(0)
+        for i := 0; i < nw; i++ {
+            if id := st[src[ip + i]]; id == 0xff {
+                return 0, CorruptInputError(ip + i)
+            } else {
+                cv = cv * 58 + uint64(id)
+            }
+        }
+
+        /* multiply the limbs by 58^nw, and add the group */
+        mv := pow58(nw)
+        for i := range ls {
+            cv += uint64(ls[i]) * mv
+            ls[i] = uint32(cv)
+            cv >>= 32
+        }
+
+        /* propagate the carry into new limbs */
+        for cv != 0 {
+            ls = append(ls, uint32(cv))
+            cv >>= 32
+        }
+        ip += nw
+    }
+
+    /* strip the leading zero bytes of the most significant limb */
+    nl := len(ls) * 4
+    if len(ls) != 0 {
+        for v := ls[len(ls) - 1]; v & 0xff000000 == 0; v <<= 8 {
+            nl--
+        }
+    }
+
+    /* the leading zeros, then the limbs from the most significant one */
+    nd := len(*out)
+    ret := (*out)[:nd + nz + nl]
+    buf := ret[nd:]
+
+    /* decode the leading zeros */
+    for i := 0; i < nz; i++ {
+        buf[i] = 0
+    }
+
+    /* store every limb in big-endian */
+    for i, op := 0, len(buf); i < len(ls); i++ {
+        for v, j := ls[i], 0; j < 4 && op > nz; j++ {
+            op--
+            buf[op] = byte(v)
+            v >>= 8
+        }
+    }
+
+    /* update the output length */
+    *out = ret
+    return len(buf), nil
+}
+
+// DecodeString returns the bytes represented by the base58 string s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.MaxDecodedLen(len(s)))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// MaxDecodedLen returns the maximum length in bytes of the decoded data
+// corresponding to n bytes of base58-encoded data.
+func (self Encoding) MaxDecodedLen(n int) int {
+    return n
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base58
+
+import (
+    `bytes`
+    `encoding/hex`
+    `math/big`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+// Bitcoin Core base58_encode_decode.json, with hex-encoded inputs
+var pairs = []TestPair{
+    {"", ""},
+    {"61", "2g"},
+    {"626262", "a3gV"},
+    {"636363", "aPEr"},
+    {"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+    {"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+    {"516b6fcd0f", "ABnLTmg"},
+    {"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+    {"572e4794", "3EFU7m"},
+    {"ecac89cad93923c02321", "EJDM8drfXA6uyA"},
+    {"10c8511e", "Rt5zm"},
+    {"00000000000000000000", "1111111111"},
+    {
+        "000111d38e5fc9071ffcd20b4a763cc9ae4f252bb4e48fd66a835e252ada93ff480d6dd43dc62a641155a5",
+        "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+    },
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func mustHex(s string) []byte {
+    if v, err := hex.DecodeString(s); err != nil {
+        panic(err)
+    } else {
+        return v
+    }
+}
+
+// refEncode is the textbook big-integer algorithm.
+func refEncode(src []byte, charset string) string {
+    var ret []byte
+    var mod big.Int
+
+    /* repeatedly divide by 58 */
+    v := new(big.Int).SetBytes(src)
+    for r := big.NewInt(58); v.Sign() != 0; {
+        v.DivMod(v, r, &mod)
+        ret = append(ret, charset[mod.Int64()])
+    }
+
+    /* leading zeros */
+    for i := 0; i < len(src) && src[i] == 0; i++ {
+        ret = append(ret, charset[0])
+    }
+
+    /* reverse the digits */
+    for i, j := 0, len(ret) - 1; i < j; i, j = i + 1, j - 1 {
+        ret[i], ret[j] = ret[j], ret[i]
+    }
+    return string(ret)
+}
+
+func TestEncoder(t *testing.T) {
+    for _, p := range pairs {
+        got := StdEncoding.EncodeToString(mustHex(p.decoded))
+        testEqual(t, "Encode(%s) = %q, want %q", p.decoded, got, p.encoded)
+    }
+}
+
+func TestDecoder(t *testing.T) {
+    for _, p := range pairs {
+        dbuf := make([]byte, StdEncoding.MaxDecodedLen(len(p.encoded)))
+        count, err := StdEncoding.Decode(dbuf, []byte(p.encoded))
+        testEqual(t, "Decode(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "Decode(%q) = %x, want %s", p.encoded, hex.EncodeToString(dbuf[:count]), p.decoded)
+
+        dbuf, err = StdEncoding.DecodeString(p.encoded)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "DecodeString(%q) = %x, want %s", p.encoded, hex.EncodeToString(dbuf), p.decoded)
+    }
+}
+
+func TestDecoderError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        pos int
+    }{
+        {"0", 0},
+        {"1110", 3},
+        {"3SEo3LWLoPntC\n", 13},
+        {"3SEo3LWLIPntC", 8},
+        {"l", 0},
+    } {
+        _, err := StdEncoding.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(CorruptInputError(tt.pos)))
+    }
+}
+
+func TestAlphabets(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, enc := range []Encoding{StdEncoding, FlickrEncoding, RippleEncoding} {
+        for n := 0; n < 200; n++ {
+            src := make([]byte, n)
+            rng.Read(src)
+
+            /* some leading zeros */
+            for i := 0; i < n % 4 && i < n; i++ {
+                src[i] = 0
+            }
+
+            /* compare with the reference implementation */
+            got := enc.EncodeToString(src)
+            testEqual(t, "EncodeToString(%x) = %q, want %q", src, got, refEncode(src, charsets[enc]))
+
+            /* decode must round-trip */
+            dec, err := enc.DecodeString(got)
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("DecodeString(%q) = %x, %v, want %x", got, dec, err, src)
+            }
+        }
+    }
+}
+
+func TestCheck(t *testing.T) {
+    // the address of the Bitcoin genesis block
+    ver, payload, err := StdEncoding.CheckDecodeString("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+    testEqual(t, "CheckDecodeString() = error %v, want %v", err, error(nil))
+    testEqual(t, "CheckDecodeString() = version %d, want %d", ver, byte(0))
+    testEqual(t, "CheckDecodeString() = %x, want %s", hex.EncodeToString(payload), "62e907b15cbf27d5425399ebf6f0fb50ebb88f18")
+
+    /* encode must round-trip */
+    got := StdEncoding.CheckEncodeToString(ver, payload)
+    testEqual(t, "CheckEncodeToString() = %q, want %q", got, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa")
+    for _, enc := range []Encoding{FlickrEncoding, RippleEncoding} {
+        ver, dec, err := enc.CheckDecodeString(enc.CheckEncodeToString(0x80, []byte("payload")))
+        if err != nil || ver != 0x80 || string(dec) != "payload" {
+            t.Errorf("CheckDecodeString() = %d, %q, %v", ver, dec, err)
+        }
+    }
+
+    /* checksum mismatches */
+    _, _, err = StdEncoding.CheckDecodeString("1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb")
+    testEqual(t, "CheckDecodeString() = error %v, want %v", err, ErrChecksum)
+    _, _, err = StdEncoding.CheckDecodeString(strings.Repeat("1", 4))
+    testEqual(t, "CheckDecodeString() = error %v, want %v", err, ErrInvalidFormat)
+    _, _, err = StdEncoding.CheckDecodeString("1A1zP1eP5QGefi2DMPTfTL5SLmv7Di0fNa")
+    testEqual(t, "CheckDecodeString() = error %v, want %v", err, error(CorruptInputError(30)))
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base58
+
+import (
+    `crypto/sha256`
+    `errors`
+)
+
+const (
+    _CHECKSUM_LEN = 4
+)
+
+var (
+    // ErrChecksum is returned when the checksum of a Base58Check string
+    // does not match its content.
+    ErrChecksum = errors.New("base58: checksum mismatch")
+
+    // ErrInvalidFormat is returned when a Base58Check string is too short
+    // to hold the version byte and the checksum.
+    ErrInvalidFormat = errors.New("base58: invalid Base58Check format")
+)
+
+// checksum returns the first 4 bytes of the double SHA-256 of src.
+func checksum(src []byte) (ret [_CHECKSUM_LEN]byte) {
+    h0 := sha256.Sum256(src)
+    h1 := sha256.Sum256(h0[:])
+    copy(ret[:], h1[:])
+    return
+}
+
+// CheckEncodeToString returns the Base58Check encoding of payload with
+// the given version byte, that is, the base58 encoding of the version
+// byte, the payload and the first 4 bytes of their double SHA-256.
+func (self Encoding) CheckEncodeToString(version byte, payload []byte) string {
+    buf := make([]byte, 0, 1 + len(payload) + _CHECKSUM_LEN)
+    buf = append(buf, version)
+    buf = append(buf, payload...)
+
+    /* append the checksum */
+    sum := checksum(buf)
+    buf = append(buf, sum[:]...)
+    return self.EncodeToString(buf)
+}
+
+// CheckDecodeString decodes a Base58Check string, and returns the
+// version byte and the payload. It returns CorruptInputError for
+// invalid characters, ErrInvalidFormat if s is too short, and
+// ErrChecksum if the checksum does not match.
+func (self Encoding) CheckDecodeString(s string) (version byte, payload []byte, err error) {
+    var buf []byte
+    var sum [_CHECKSUM_LEN]byte
+
+    /* decode the string */
+    if buf, err = self.DecodeString(s); err != nil {
+        return 0, nil, err
+    }
+
+    /* must have the version byte and the checksum */
+    if len(buf) < 1 + _CHECKSUM_LEN {
+        return 0, nil, ErrInvalidFormat
+    }
+
+    /* verify the checksum */
+    nb := len(buf) - _CHECKSUM_LEN
+    copy(sum[:], buf[nb:])
+
+    /* split the version and the payload */
+    if checksum(buf[:nb]) != sum {
+        return 0, nil, ErrChecksum
+    } else {
+        return buf[0], buf[1:nb], nil
+    }
+}
@@ -20,6 +20,7 @@ import (
     `encoding/base64`
 
     "github.com/cloudwego/base64x/internal/native"
+    "github.com/cloudwego/base64x/internal/rt"
 )
 
 // An Encoding is a radix 64 encoding/decoding scheme, defined by a
@@ -34,6 +35,14 @@ const (
     _MODE_RAW  = 1 << 1
     _MODE_AVX2 = 1 << 2
     _MODE_JSON = 1 << 3
+    _MODE_ANY     = 1 << 4
+    _MODE_UNMIXED = 1 << 5
+)
+
+// Modes handled in Go, on top of the native code, which never sees them.
+const (
+    _MODE_OPTPAD  = 1 << 6
+    _MODE_GO      = _MODE_OPTPAD
 )
 
 // StdEncoding is the standard base64 encoding, as defined in
@@ -89,7 +98,11 @@ func (self Encoding) Encode(out []byte, src []byte) {
 //
 // It will also update the length of out.
 func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
-    native.B64Encode(out, &src, int(self) | archFlags)
+    if len(src) <= _SMALL_ENCODE {
+        self.encodeSmall(out, src)
+    } else {
+        native.B64Encode(out, &src, int(self &^ _MODE_GO) | archFlags)
+    }
 }
 
 // EncodeToString returns the base64 encoding of src.
@@ -99,7 +112,7 @@ func (self Encoding) EncodeToString(src []byte) string {
 
     /* encode in native code */
     self.EncodeUnsafe(&ret, src)
-    return mem2str(ret)
+    return rt.Mem2Str(ret)
 }
 
 // EncodedLen returns the length in bytes of the base64 encoding
@@ -126,10 +139,29 @@ func (self Encoding) EncodedLen(n int) int {
 func (self Encoding) Decode(out []byte, src []byte) (int, error) {
     if len(src) == 0 {
         return 0, nil
-    } else if buf := out[:0:len(out)]; self.DecodedLen(len(src)) <= len(out) {
+    } else if self.DecodedLen(len(src)) > len(out) {
+        panic("decoder output buffer is too small")
+    }
+
+    /* the last group of src might need more room than DecodedLen */
+    if buf := out[:0:len(out)]; self.DecodedCap(len(src)) <= len(out) {
         return self.DecodeUnsafe(&buf, src)
     } else {
+        return self.decodeShort(out, src)
+    }
+}
+
+// decodeShort decodes src into a temporary buffer of DecodedCap bytes,
+// and copies the result into out, which is shorter than that.
+func (self Encoding) decodeShort(out []byte, src []byte) (int, error) {
+    buf := make([]byte, 0, self.DecodedCap(len(src)))
+    ret, err := self.DecodeUnsafe(&buf, src)
+
+    /* a partial padding might make the result too large */
+    if ret > len(out) {
         panic("decoder output buffer is too small")
+    } else {
+        return copy(out, buf[:ret]), err
     }
 }
 
@@ -138,6 +170,18 @@ func (self Encoding) Decode(out []byte, src []byte) (int, error) {
 //
 // It will also update the length of out.
 func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    if (self & _MODE_GO) != 0 {
+        return self.decodePadding(out, src)
+    }
+
+    /* tiny inputs are decoded in Go */
+    if len(src) <= _SMALL_DECODE {
+        if n, ok := self.decodeSmall(out, src); ok {
+            return n, nil
+        }
+    }
+
+    /* decode in native code */
     if n := native.B64Decode(out, mem2addr(src), len(src), int(self) | archFlags); n >= 0 {
         return n, nil
     } else {
@@ -147,8 +191,8 @@ func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
 
 // DecodeString returns the bytes represented by the base64 string s.
 func (self Encoding) DecodeString(s string) ([]byte, error) {
-    src := str2mem(s)
-    ret := make([]byte, 0, self.DecodedLen(len(s)))
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.DecodedCap(len(s)))
 
     /* decode into the allocated buffer */
     if _, err := self.DecodeUnsafe(&ret, src); err != nil {
@@ -161,9 +205,22 @@ func (self Encoding) DecodeString(s string) ([]byte, error) {
 // DecodedLen returns the maximum length in bytes of the decoded data
 // corresponding to n bytes of base64-encoded data.
 func (self Encoding) DecodedLen(n int) int {
-    if (self & _MODE_RAW) == 0 {
+    if (self & (_MODE_RAW | _MODE_OPTPAD)) == 0 {
         return n / 4 * 3
     } else {
         return n * 6 / 8
     }
 }
+
+// DecodedCap returns the size of the buffer that DecodeUnsafe needs to
+// decode n bytes of base64-encoded data. It is DecodedLen rounded up to
+// whole groups of 4 characters for the padded encodings, since their
+// decoder also accepts a partial padding at the end, such as "Zg=", and
+// stores the byte of it.
+func (self Encoding) DecodedCap(n int) int {
+    if (self & (_MODE_RAW | _MODE_OPTPAD)) == 0 {
+        return (n + 3) / 4 * 3
+    } else {
+        return n * 6 / 8
+    }
+}
@@ -255,3 +255,25 @@ func TestDecoderError(t *testing.T) {
         panic(err)
     } 
 }
+
+func TestDecoderShortPadding(t *testing.T) {
+    for _, src := range []string{"Zg=", "Zm9vYg=", "Zg=\n"} {
+        ret, err := StdEncoding.DecodeString(src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, error(nil))
+        testEqual(t, "DecodeString(%q) = cap %v, want >= %v", src, cap(ret) >= len(ret), true)
+        buf := make([]byte, StdEncoding.DecodedCap(len(src)))
+        n, err := StdEncoding.Decode(buf, []byte(src))
+        testEqual(t, "Decode(%q) = error %v, want %v", src, err, error(nil))
+        testEqual(t, "Decode(%q) = %q, want %q", src, string(buf[:n]), string(ret))
+    }
+
+    /* DecodedLen is too small for the partial padding of the last group */
+    buf := []byte("xxxx")
+    func() {
+        defer func() {
+            testEqual(t, "Decode(%q) = panic %v, want %v", "Zm9vYg=", recover() != nil, true)
+        }()
+        StdEncoding.Decode(buf[:StdEncoding.DecodedLen(7)], []byte("Zm9vYg="))
+    }()
+    testEqual(t, "Decode(%q) = %q, want %q", "Zm9vYg=", string(buf[3:]), "x")
+}
@@ -0,0 +1,335 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Package basicauth parses and formats the credentials of the HTTP
+// Basic authentication scheme (RFC 7617), which are the user-id and the
+// password joined by a colon, and encoded with base64x.StdEncoding.
+//
+// Parsing does not allocate as long as the caller provides a large
+// enough buffer, which makes it suitable for gateways that check the
+// Authorization header of every request.
+package basicauth
+
+import (
+    `encoding/base64`
+    `errors`
+    `strconv`
+    `unicode/utf8`
+
+    `github.com/cloudwego/base64x`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+const (
+    _SCHEME  = "Basic"
+    _CHARSET = "UTF-8"
+)
+
+var (
+    // ErrScheme is returned by Parse for credentials of another scheme.
+    ErrScheme = errors.New("basicauth: not the Basic authentication scheme")
+
+    // ErrFormat is returned by Parse for credentials without a token, or
+    // with something else after it.
+    ErrFormat = errors.New("basicauth: malformed credentials")
+
+    // ErrNoColon is returned by Parse for tokens that do not contain a
+    // colon between the user-id and the password.
+    ErrNoColon = errors.New("basicauth: no colon in the credentials")
+
+    // ErrUserColon is returned by AppendHeader for user-ids that contain
+    // a colon, which could not be told apart from the password.
+    ErrUserColon = errors.New("basicauth: colon in the user-id")
+
+    // ErrCharset is returned for user-ids and passwords with control
+    // characters, or that are not valid in the charset.
+    ErrCharset = errors.New("basicauth: invalid characters in the credentials")
+)
+
+// CorruptTokenError is returned by Parse for tokens that are not valid
+// base64 data. The offset is relative to the beginning of the header.
+type CorruptTokenError int64
+
+func (e CorruptTokenError) Error() string {
+    return "basicauth: illegal base64 data at header byte " + strconv.FormatInt(int64(e), 10)
+}
+
+// A Charset is the character encoding of the user-id and the password,
+// which RFC 7617 leaves to the client unless the server announces
+// UTF-8 in its challenge.
+type Charset int
+
+const (
+    // CharsetNone takes the user-id and the password as they are, like
+    // net/http does.
+    CharsetNone Charset = iota
+
+    // CharsetUTF8 requires them to be valid UTF-8 without control
+    // characters, as announced with the charset="UTF-8" parameter. They
+    // are not normalized, which RFC 7617 leaves to the applications.
+    CharsetUTF8
+
+    // CharsetLatin1 encodes them in ISO-8859-1, as most clients do
+    // without the charset parameter, and converts them from and to
+    // UTF-8, without control characters either.
+    CharsetLatin1
+)
+
+// Parse is CharsetNone.Parse.
+func Parse(dst []byte, header string) (user []byte, password []byte, err error) {
+    return CharsetNone.Parse(dst, header)
+}
+
+// AppendHeader is CharsetNone.AppendHeader.
+func AppendHeader(dst []byte, user string, password string) ([]byte, error) {
+    return CharsetNone.AppendHeader(dst, user, password)
+}
+
+// Parse parses the value of an Authorization header with the Basic
+// scheme, and returns the user-id and the password. The scheme is
+// case-insensitive, and may be surrounded by any spaces or tabs.
+//
+// The token is decoded into dst, which is reused if its capacity is at
+// least len(header), or twice that for CharsetLatin1, and the results
+// are slices of it. Otherwise a new buffer is allocated.
+func (self Charset) Parse(dst []byte, header string) (user []byte, password []byte, err error) {
+    src := rt.Str2Mem(header)
+    i := skipBlanks(src, 0)
+
+    /* the scheme, followed by blanks */
+    if len(src) - i < len(_SCHEME) || !equalFold(src[i:i + len(_SCHEME)], _SCHEME) {
+        return nil, nil, ErrScheme
+    }
+    if i += len(_SCHEME); i < len(src) && !isBlank(src[i]) {
+        return nil, nil, ErrScheme
+    }
+
+    /* the token, followed by nothing but blanks */
+    p := skipBlanks(src, i)
+    q := p
+    for q < len(src) && !isBlank(src[q]) {
+        q++
+    }
+    if p == q || skipBlanks(src, q) != len(src) {
+        return nil, nil, ErrFormat
+    }
+
+    /* decode the token */
+    buf, err := decodeToken(dst, src[p:q], self, p)
+    if err != nil {
+        return nil, nil, err
+    }
+
+    /* convert and check the characters */
+    if buf, err = self.decode(buf); err != nil {
+        return nil, nil, err
+    }
+
+    /* split at the first colon */
+    for j, c := range buf {
+        if c == ':' {
+            return buf[:j:j], buf[j + 1:], nil
+        }
+    }
+    return nil, nil, ErrNoColon
+}
+
+// AppendHeader appends the value of an Authorization header with the
+// Basic scheme, that is "Basic " and the token, to dst, and returns the
+// extended buffer. The user-id and the password are UTF-8 strings, and
+// are encoded in the charset.
+func (self Charset) AppendHeader(dst []byte, user string, password string) ([]byte, error) {
+    for i := 0; i < len(user); i++ {
+        if user[i] == ':' {
+            return dst, ErrUserColon
+        }
+    }
+
+    /* the length of the credentials */
+    nb, err := self.encodedLen(user, password)
+    if err != nil {
+        return dst, err
+    }
+
+    /* the scheme */
+    ret := rt.GrowSlice(dst, len(_SCHEME) + 1 + base64x.StdEncoding.EncodedLen(nb))
+    ret = append(ret, _SCHEME + " "...)
+
+    /* write the credentials and encode them in place */
+    buf := ret[len(ret):len(ret) + nb]
+    buf = self.encode(self.encode(buf[:0], user), ":")
+    buf = self.encode(buf, password)
+    return ret[:len(ret) + len(base64x.StdEncoding.EncodeInPlace(buf, nb))], nil
+}
+
+// AppendChallenge appends the value of a WWW-Authenticate header with
+// the Basic scheme to dst, with the realm and, for CharsetUTF8, the
+// charset parameter, and returns the extended buffer.
+func (self Charset) AppendChallenge(dst []byte, realm string) []byte {
+    dst = append(dst, _SCHEME + ` realm="`...)
+    for i := 0; i < len(realm); i++ {
+        if realm[i] == '"' || realm[i] == '\\' {
+            dst = append(dst, '\\')
+        }
+        dst = append(dst, realm[i])
+    }
+
+    /* only UTF-8 may be announced */
+    if dst = append(dst, '"'); self == CharsetUTF8 {
+        dst = append(dst, `, charset="` + _CHARSET + `"`...)
+    }
+    return dst
+}
+
+// decodeToken decodes the token into dst, which is reused if it is large
+// enough. off is the offset of the token in the header.
+func decodeToken(dst []byte, tok []byte, cs Charset, off int) ([]byte, error) {
+    buf := dst[:0]
+    nb := base64x.StdEncoding.DecodedCap(len(tok))
+
+    /* room for the UTF-8 form of the ISO-8859-1 characters */
+    if cs == CharsetLatin1 {
+        nb *= 2
+    }
+    if cap(buf) < nb {
+        buf = make([]byte, 0, nb)
+    }
+
+    /* the native decoder reports the end of the token for an invalid
+     * last character, instead of the character itself */
+    if _, err := base64x.StdEncoding.DecodeUnsafe(&buf, tok); err != nil {
+        n := int(err.(base64.CorruptInputError))
+        if n >= len(tok) {
+            n = len(tok) - 1
+        }
+        return nil, CorruptTokenError(off + n)
+    }
+
+    /* the token must be padded, and line breaks are skipped by the
+     * decoder, but not allowed here */
+    for i, c := range tok {
+        if c == '\r' || c == '\n' {
+            return nil, CorruptTokenError(off + i)
+        }
+    }
+    if len(tok) % 4 != 0 {
+        return nil, CorruptTokenError(off + len(tok))
+    }
+    return buf, nil
+}
+
+// decode checks the decoded credentials in buf, and converts them to
+// UTF-8 in place, which buf has the capacity for.
+func (self Charset) decode(buf []byte) ([]byte, error) {
+    if self == CharsetNone {
+        return buf, nil
+    }
+
+    /* no control characters */
+    nh := 0
+    for _, c := range buf {
+        if c < 0x20 || c == 0x7f {
+            return nil, ErrCharset
+        } else if c >= 0x80 {
+            nh++
+        }
+    }
+
+    /* the characters must be valid */
+    if self == CharsetUTF8 {
+        if !utf8.Valid(buf) {
+            return nil, ErrCharset
+        } else {
+            return buf, nil
+        }
+    }
+
+    /* expand the ISO-8859-1 characters back-to-front */
+    n := len(buf)
+    buf = buf[:n + nh]
+    for i, j := n - 1, n + nh - 1; i < j; i-- {
+        if c := buf[i]; c < 0x80 {
+            buf[j] = c
+            j--
+        } else {
+            buf[j] = 0x80 | c & 0x3f
+            buf[j - 1] = 0xc0 | c >> 6
+            j -= 2
+        }
+    }
+    return buf, nil
+}
+
+// encodedLen returns the length of the credentials in the charset.
+func (self Charset) encodedLen(user string, password string) (int, error) {
+    nb := len(user) + len(password) + 1
+    if self == CharsetNone {
+        return nb, nil
+    }
+
+    /* check the characters, and count them for ISO-8859-1 */
+    nr := 1
+    for _, s := range [2]string{user, password} {
+        if !utf8.ValidString(s) {
+            return 0, ErrCharset
+        }
+        for _, r := range s {
+            if r < 0x20 || r == 0x7f || (self == CharsetLatin1 && r > 0xff) {
+                return 0, ErrCharset
+            }
+            nr++
+        }
+    }
+
+    /* ISO-8859-1 has one byte for every character */
+    if self == CharsetLatin1 {
+        return nr, nil
+    } else {
+        return nb, nil
+    }
+}
+
+// encode appends s in the charset to dst, which has the capacity for it.
+func (self Charset) encode(dst []byte, s string) []byte {
+    if self != CharsetLatin1 {
+        return append(dst, s...)
+    }
+    for _, r := range s {
+        dst = append(dst, byte(r))
+    }
+    return dst
+}
+
+func equalFold(s []byte, t string) bool {
+    for i := 0; i < len(s); i++ {
+        if s[i] | 0x20 != t[i] | 0x20 {
+            return false
+        }
+    }
+    return true
+}
+
+func isBlank(c byte) bool {
+    return c == ' ' || c == '\t'
+}
+
+func skipBlanks(src []byte, i int) int {
+    for i < len(src) && isBlank(src[i]) {
+        i++
+    }
+    return i
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `encoding/base64`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+)
+
+/** Batch Functions **/
+
+// EncodeBatch encodes every item of srcs into dst, one after another,
+// writing EncodedBatchLen(srcs) bytes to dst. It returns len(srcs) + 1
+// offsets, with the i-th item encoded in dst[offsets[i]:offsets[i+1]].
+//
+// This avoids the allocation of EncodeToString for every item, which
+// dominates when the items are small, and the whole batch is encoded by
+// a single native call; see the Batch cases in bench/ for the difference.
+//
+// If dst is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) EncodeBatch(dst []byte, srcs [][]byte) (offsets []int) {
+    if self.EncodedBatchLen(srcs) > len(dst) {
+        panic("encoder output buffer is too small")
+    }
+
+    /* encode every item into the arena in native code */
+    buf := dst[:0:len(dst)]
+    ret := make([]int, len(srcs) + 1)
+    if len(srcs) != 0 {
+        native.B64EncodeBatch(&buf, unsafe.Pointer(&srcs[0]), len(srcs), unsafe.Pointer(&ret[1]), int(self &^ _MODE_GO) | archFlags)
+    }
+    return ret
+}
+
+// EncodedBatchLen returns the length in bytes of the base64 encoding
+// of all the items of srcs.
+func (self Encoding) EncodedBatchLen(srcs [][]byte) int {
+    n := 0
+    for _, src := range srcs {
+        n += self.EncodedLen(len(src))
+    }
+    return n
+}
+
+// DecodeBatch decodes every item of srcs into dst, one after another.
+// It writes at most DecodedBatchLen(srcs) bytes to dst, and returns
+// len(srcs) + 1 offsets, with the i-th item decoded in
+// dst[offsets[i]:offsets[i+1]]. Like EncodeBatch, it decodes the whole
+// batch by a single native call, except for the encodings decoded in Go,
+// such as the lenient ones, which decode the items one by one.
+//
+// Invalid items are decoded as empty, and errs holds the
+// base64.CorruptInputError of every invalid item at its index, or is
+// nil if all the items are valid.
+//
+// If dst is not large enough to contain the decoded result,
+// it will panic.
+func (self Encoding) DecodeBatch(dst []byte, srcs [][]byte) (offsets []int, errs []error) {
+    if self.DecodedBatchLen(srcs) > len(dst) {
+        panic("decoder output buffer is too small")
+    }
+
+    /* decode every item into the arena in native code */
+    if (self & _MODE_GO) == 0 {
+        return self.decodeBatch(dst, srcs)
+    }
+
+    /* decode every item into the arena */
+    buf := dst[:0:len(dst)]
+    ret := make([]int, 1, len(srcs) + 1)
+    for i, src := range srcs {
+        if len(src) != 0 {
+            if _, err := self.DecodeUnsafe(&buf, src); err != nil {
+                if errs == nil {
+                    errs = make([]error, len(srcs))
+                }
+                errs[i] = err
+            }
+        }
+        ret = append(ret, len(buf))
+    }
+    return ret, errs
+}
+
+// decodeBatch is DecodeBatch with a single native call. The offsets of
+// the invalid items are the error positions as returned by B64Decode,
+// and they are replaced by the offsets of the previous items.
+func (self Encoding) decodeBatch(dst []byte, srcs [][]byte) (offsets []int, errs []error) {
+    buf := dst[:0:len(dst)]
+    ret := make([]int, len(srcs) + 1)
+
+    /* decode the batch, and check for invalid items */
+    if len(srcs) == 0 || native.B64DecodeBatch(&buf, unsafe.Pointer(&srcs[0]), len(srcs), unsafe.Pointer(&ret[1]), int(self) | archFlags) == 0 {
+        return ret, nil
+    }
+
+    /* collect the errors of the invalid items */
+    errs = make([]error, len(srcs))
+    for i := range srcs {
+        if ret[i + 1] < 0 {
+            errs[i] = base64.CorruptInputError(-ret[i + 1] - 1)
+            ret[i + 1] = ret[i]
+        }
+    }
+    return ret, errs
+}
+
+// DecodedBatchLen returns the maximum length in bytes of the decoded
+// data of all the items of srcs.
+//
+// Every item takes DecodedCap bytes, which leaves room for a partial
+// padding at its end, such as "Zg=".
+func (self Encoding) DecodedBatchLen(srcs [][]byte) int {
+    n := 0
+    for _, src := range srcs {
+        n += self.DecodedCap(len(src))
+    }
+    return n
+}
@@ -0,0 +1,152 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `testing`
+)
+
+func TestEncodeBatch(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    srcs := make([][]byte, 1000)
+    for i := range srcs {
+        srcs[i] = make([]byte, rng.Intn(80))
+        rng.Read(srcs[i])
+    }
+
+    /* every encoding */
+    for _, tt := range encodingTests {
+        dst := make([]byte, tt.enc.EncodedBatchLen(srcs))
+        offs := tt.enc.EncodeBatch(dst, srcs)
+        testEqual(t, "EncodeBatch() = %d offsets, want %d", len(offs), len(srcs) + 1)
+        testEqual(t, "EncodeBatch() = %d bytes, want %d", offs[len(srcs)], len(dst))
+
+        /* compare with EncodeToString */
+        for i, src := range srcs {
+            got := string(dst[offs[i]:offs[i + 1]])
+            testEqual(t, "EncodeBatch(%x) = %q, want %q", src, got, tt.enc.EncodeToString(src))
+        }
+
+        /* decode must round-trip */
+        var encs [][]byte
+        for i := range srcs {
+            encs = append(encs, dst[offs[i]:offs[i + 1]])
+        }
+        out := make([]byte, tt.enc.DecodedBatchLen(encs))
+        doff, errs := tt.enc.DecodeBatch(out, encs)
+        if errs != nil {
+            t.Fatalf("DecodeBatch() = %v", errs)
+        }
+        for i, src := range srcs {
+            if got := out[doff[i]:doff[i + 1]]; !bytes.Equal(got, src) {
+                t.Errorf("DecodeBatch(%q) = %x, want %x", encs[i], got, src)
+            }
+        }
+    }
+}
+
+func TestDecodeBatchError(t *testing.T) {
+    srcs := [][]byte{[]byte("Zm9v"), []byte("Zm!v"), nil, []byte("YmFy"), []byte("Y")}
+    dst := make([]byte, StdEncoding.DecodedBatchLen(srcs))
+    offs, errs := StdEncoding.DecodeBatch(dst, srcs)
+
+    /* invalid items are empty */
+    want := []string{"foo", "", "", "bar", ""}
+    for i := range srcs {
+        testEqual(t, "DecodeBatch(%q) = %q, want %q", srcs[i], string(dst[offs[i]:offs[i + 1]]), want[i])
+    }
+
+    /* per-item errors */
+    testEqual(t, "DecodeBatch() = %d errors, want %d", len(errs), len(srcs))
+    testEqual(t, "DecodeBatch() = error %v, want %v", errs[0], error(nil))
+    testEqual(t, "DecodeBatch() = error %v, want %v", errs[1], error(base64.CorruptInputError(2)))
+    testEqual(t, "DecodeBatch() = error %v, want %v", errs[3], error(nil))
+    if errs[4] == nil {
+        t.Errorf("DecodeBatch(%q) = nil error", srcs[4])
+    }
+}
+
+func TestDecodeBatchItems(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZaz09+/-_=\r\n!"
+    srcs := make([][]byte, 1000)
+    for i := range srcs {
+        buf := make([]byte, rng.Intn(80))
+        rng.Read(buf)
+        srcs[i] = []byte(StdEncoding.EncodeToString(buf))
+        for j := rng.Intn(4); j > 0 && len(srcs[i]) != 0 && i % 2 == 0; j-- {
+            srcs[i][rng.Intn(len(srcs[i]))] = alphabet[rng.Intn(len(alphabet))]
+        }
+    }
+
+    /* the items must decode as they do one by one, natively or in Go */
+    for _, enc := range []Encoding{StdEncoding, URLEncoding, RawStdEncoding, JSONStdEncoding, StdEncoding.Lenient()} {
+        dst := make([]byte, enc.DecodedBatchLen(srcs))
+        offs, errs := enc.DecodeBatch(dst, srcs)
+        for i, src := range srcs {
+            buf := make([]byte, 0, enc.DecodedCap(len(src)))
+            _, err := enc.DecodeUnsafe(&buf, src)
+            if err != nil {
+                buf = buf[:0]
+            }
+            if errs == nil {
+                testEqual(t, "DecodeBatch(%q) = error %v, want %v", src, error(nil), err)
+            } else {
+                testEqual(t, "DecodeBatch(%q) = error %v, want %v", src, errs[i], err)
+            }
+            testEqual(t, "DecodeBatch(%q) = %q, want %q", src, string(dst[offs[i]:offs[i + 1]]), string(buf))
+        }
+    }
+}
+
+func TestDecodeBatchShortPadding(t *testing.T) {
+    srcs := [][]byte{[]byte("Zm9v"), []byte("Zg=")}
+    buf := bytes.Repeat([]byte{'*'}, 16)
+    dst := buf[:StdEncoding.DecodedBatchLen(srcs)]
+    offs, errs := StdEncoding.DecodeBatch(dst, srcs)
+
+    /* the partial padding is decoded within dst */
+    if errs != nil {
+        t.Fatalf("DecodeBatch() = %v", errs)
+    }
+    if offs[len(srcs)] > len(dst) {
+        t.Fatalf("DecodeBatch() = %d bytes, beyond %d", offs[len(srcs)], len(dst))
+    }
+    testEqual(t, "DecodeBatch() = %q, want %q", string(dst[offs[0]:offs[2]]), "foof")
+    testEqual(t, "DecodeBatch() = %q, want %q", string(buf[len(dst):]), string(bytes.Repeat([]byte{'*'}, 16 - len(dst))))
+}
+
+func TestBatchPanics(t *testing.T) {
+    srcs := [][]byte{[]byte("foo")}
+    for _, fn := range []func(){
+        func() { StdEncoding.EncodeBatch(make([]byte, 3), srcs) },
+        func() { StdEncoding.DecodeBatch(make([]byte, 2), [][]byte{[]byte("Zm9v")}) },
+        func() { StdEncoding.DecodeBatch(make([]byte, 3), [][]byte{[]byte("Zm9v"), []byte("Zg=")}) },
+    } {
+        func() {
+            defer func() {
+                if recover() == nil {
+                    t.Errorf("expected panic")
+                }
+            }()
+            fn()
+        }()
+    }
+}
@@ -2,11 +2,15 @@ package bench
 
 import (
 	"testing"
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/hex"
 	`crypto/rand`
 	`io`
 
 	. "github.com/cloudwego/base64x"
+	xbase32 "github.com/cloudwego/base64x/base32"
+	xhex "github.com/cloudwego/base64x/hex"
 	cris "github.com/cristalhq/base64"
 )
 
@@ -75,6 +79,10 @@ func benchmarkCrisWithSize(b *testing.B, nb int) {
     })
 }
 
+func BenchmarkEncoderStdlib_2B     (b *testing.B) { benchmarkStdlibWithSize(b, 2) }
+func BenchmarkEncoderStdlib_3B     (b *testing.B) { benchmarkStdlibWithSize(b, 3) }
+func BenchmarkEncoderStdlib_8B     (b *testing.B) { benchmarkStdlibWithSize(b, 8) }
+func BenchmarkEncoderStdlib_12B    (b *testing.B) { benchmarkStdlibWithSize(b, 12) }
 func BenchmarkEncoderStdlib_16B    (b *testing.B) { benchmarkStdlibWithSize(b, 16) }
 func BenchmarkEncoderStdlib_56B    (b *testing.B) { benchmarkStdlibWithSize(b, 56) }
 func BenchmarkEncoderStdlib_128B   (b *testing.B) { benchmarkStdlibWithSize(b, 128) }
@@ -82,6 +90,10 @@ func BenchmarkEncoderStdlib_4kB    (b *testing.B) { benchmarkStdlibWithSize(b, 4
 func BenchmarkEncoderStdlib_256kB  (b *testing.B) { benchmarkStdlibWithSize(b, 256 * 1024) }
 func BenchmarkEncoderStdlib_1MB    (b *testing.B) { benchmarkStdlibWithSize(b, 1024 * 1024) }
 
+func BenchmarkEncoderBase64x_2B    (b *testing.B) { benchmarkBase64xWithSize(b, 2) }
+func BenchmarkEncoderBase64x_3B    (b *testing.B) { benchmarkBase64xWithSize(b, 3) }
+func BenchmarkEncoderBase64x_8B    (b *testing.B) { benchmarkBase64xWithSize(b, 8) }
+func BenchmarkEncoderBase64x_12B   (b *testing.B) { benchmarkBase64xWithSize(b, 12) }
 func BenchmarkEncoderBase64x_16B   (b *testing.B) { benchmarkBase64xWithSize(b, 16) }
 func BenchmarkEncoderBase64x_56B   (b *testing.B) { benchmarkBase64xWithSize(b, 56) }
 func BenchmarkEncoderBase64x_128B  (b *testing.B) { benchmarkBase64xWithSize(b, 128) }
@@ -99,3 +111,219 @@ func BenchmarkEncoderCris_1MB    (b *testing.B) { benchmarkCrisWithSize(b, 1024
 var data = `////////////////////////////////////////////////////////////////`
 func BenchmarkDecoderStdLib  (b *testing.B) { benchmarkStdlibDecoder(b, data) }
 func BenchmarkDecoderBase64x (b *testing.B) { benchmarkBase64xDecoder(b, data) }
+
+func benchmarkStdlibDecoderWithSize(b *testing.B, nb int) {
+    buf := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    benchmarkStdlibDecoder(b, base64.StdEncoding.EncodeToString(buf))
+}
+
+func benchmarkBase64xDecoderWithSize(b *testing.B, nb int) {
+    buf := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    benchmarkBase64xDecoder(b, StdEncoding.EncodeToString(buf))
+}
+
+func BenchmarkDecoderStdlib_3B    (b *testing.B) { benchmarkStdlibDecoderWithSize(b, 3) }
+func BenchmarkDecoderStdlib_8B    (b *testing.B) { benchmarkStdlibDecoderWithSize(b, 8) }
+func BenchmarkDecoderStdlib_12B   (b *testing.B) { benchmarkStdlibDecoderWithSize(b, 12) }
+func BenchmarkDecoderStdlib_56B   (b *testing.B) { benchmarkStdlibDecoderWithSize(b, 56) }
+
+func BenchmarkDecoderBase64x_3B   (b *testing.B) { benchmarkBase64xDecoderWithSize(b, 3) }
+func BenchmarkDecoderBase64x_8B   (b *testing.B) { benchmarkBase64xDecoderWithSize(b, 8) }
+func BenchmarkDecoderBase64x_12B  (b *testing.B) { benchmarkBase64xDecoderWithSize(b, 12) }
+func BenchmarkDecoderBase64x_56B  (b *testing.B) { benchmarkBase64xDecoderWithSize(b, 56) }
+
+func makeBatch(n int) [][]byte {
+    srcs := make([][]byte, n)
+    for i := range srcs {
+        srcs[i] = make([]byte, 16 + i % 49)
+        _, _ = io.ReadFull(rand.Reader, srcs[i])
+    }
+    return srcs
+}
+
+func benchmarkBatchEncoderLoop(b *testing.B, srcs [][]byte) {
+    b.SetBytes(int64(StdEncoding.EncodedBatchLen(srcs) / 4 * 3))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            for _, src := range srcs {
+                _ = StdEncoding.EncodeToString(src)
+            }
+        }
+    })
+}
+
+func benchmarkBatchEncoderBase64x(b *testing.B, srcs [][]byte) {
+    b.SetBytes(int64(StdEncoding.EncodedBatchLen(srcs) / 4 * 3))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        dst := make([]byte, StdEncoding.EncodedBatchLen(srcs))
+        for pb.Next() {
+            _ = StdEncoding.EncodeBatch(dst, srcs)
+        }
+    })
+}
+
+func benchmarkBatchDecoderLoop(b *testing.B, srcs [][]byte) {
+    encs := makeEncodedBatch(srcs)
+    b.SetBytes(int64(StdEncoding.EncodedBatchLen(srcs)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            for _, src := range encs {
+                _, _ = StdEncoding.DecodeString(src)
+            }
+        }
+    })
+}
+
+func benchmarkBatchDecoderBase64x(b *testing.B, srcs [][]byte) {
+    var encs [][]byte
+    for _, src := range makeEncodedBatch(srcs) {
+        encs = append(encs, []byte(src))
+    }
+    b.SetBytes(int64(StdEncoding.EncodedBatchLen(srcs)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        dst := make([]byte, StdEncoding.DecodedBatchLen(encs))
+        for pb.Next() {
+            _, _ = StdEncoding.DecodeBatch(dst, encs)
+        }
+    })
+}
+
+func makeEncodedBatch(srcs [][]byte) []string {
+    encs := make([]string, len(srcs))
+    for i, src := range srcs {
+        encs[i] = StdEncoding.EncodeToString(src)
+    }
+    return encs
+}
+
+func BenchmarkBatchEncoderLoop_1000    (b *testing.B) { benchmarkBatchEncoderLoop(b, makeBatch(1000)) }
+func BenchmarkBatchEncoderBase64x_1000 (b *testing.B) { benchmarkBatchEncoderBase64x(b, makeBatch(1000)) }
+func BenchmarkBatchDecoderLoop_1000    (b *testing.B) { benchmarkBatchDecoderLoop(b, makeBatch(1000)) }
+func BenchmarkBatchDecoderBase64x_1000 (b *testing.B) { benchmarkBatchDecoderBase64x(b, makeBatch(1000)) }
+
+func benchmarkHexEncoder(b *testing.B, nb int, fn func(dst []byte, src []byte)) {
+    buf := make([]byte, nb)
+    dst := make([]byte, nb * 2)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    b.SetBytes(int64(nb))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            fn(dst, buf)
+        }
+    })
+}
+
+func benchmarkHexDecoder(b *testing.B, nb int, fn func(dst []byte, src []byte) (int, error)) {
+    buf := make([]byte, nb)
+    dst := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    src := []byte(hex.EncodeToString(buf))
+    b.SetBytes(int64(len(src)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            _, _ = fn(dst, src)
+        }
+    })
+}
+
+func stdHexEncode(dst []byte, src []byte) { hex.Encode(dst, src) }
+
+func BenchmarkHexEncoderStdlib_16B  (b *testing.B) { benchmarkHexEncoder(b, 16, stdHexEncode) }
+func BenchmarkHexEncoderStdlib_56B  (b *testing.B) { benchmarkHexEncoder(b, 56, stdHexEncode) }
+func BenchmarkHexEncoderStdlib_4kB  (b *testing.B) { benchmarkHexEncoder(b, 4 * 1024, stdHexEncode) }
+func BenchmarkHexEncoderBase64x_16B (b *testing.B) { benchmarkHexEncoder(b, 16, xhex.StdEncoding.Encode) }
+func BenchmarkHexEncoderBase64x_56B (b *testing.B) { benchmarkHexEncoder(b, 56, xhex.StdEncoding.Encode) }
+func BenchmarkHexEncoderBase64x_4kB (b *testing.B) { benchmarkHexEncoder(b, 4 * 1024, xhex.StdEncoding.Encode) }
+
+func BenchmarkHexDecoderStdlib_16B  (b *testing.B) { benchmarkHexDecoder(b, 16, hex.Decode) }
+func BenchmarkHexDecoderStdlib_56B  (b *testing.B) { benchmarkHexDecoder(b, 56, hex.Decode) }
+func BenchmarkHexDecoderStdlib_4kB  (b *testing.B) { benchmarkHexDecoder(b, 4 * 1024, hex.Decode) }
+func BenchmarkHexDecoderBase64x_16B (b *testing.B) { benchmarkHexDecoder(b, 16, xhex.StdEncoding.Decode) }
+func BenchmarkHexDecoderBase64x_56B (b *testing.B) { benchmarkHexDecoder(b, 56, xhex.StdEncoding.Decode) }
+func BenchmarkHexDecoderBase64x_4kB (b *testing.B) { benchmarkHexDecoder(b, 4 * 1024, xhex.StdEncoding.Decode) }
+
+func benchmarkBase32Encoder(b *testing.B, nb int, fn func(dst []byte, src []byte)) {
+    buf := make([]byte, nb)
+    dst := make([]byte, base32.StdEncoding.EncodedLen(nb))
+    _, _ = io.ReadFull(rand.Reader, buf)
+    b.SetBytes(int64(nb))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            fn(dst, buf)
+        }
+    })
+}
+
+func benchmarkBase32Decoder(b *testing.B, nb int, fn func(dst []byte, src []byte) (int, error)) {
+    buf := make([]byte, nb)
+    dst := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    src := []byte(base32.StdEncoding.EncodeToString(buf))
+    b.SetBytes(int64(len(src)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            _, _ = fn(dst, src)
+        }
+    })
+}
+
+func BenchmarkBase32EncoderStdlib_20B  (b *testing.B) { benchmarkBase32Encoder(b, 20, base32.StdEncoding.Encode) }
+func BenchmarkBase32EncoderStdlib_60B  (b *testing.B) { benchmarkBase32Encoder(b, 60, base32.StdEncoding.Encode) }
+func BenchmarkBase32EncoderStdlib_4kB  (b *testing.B) { benchmarkBase32Encoder(b, 4095, base32.StdEncoding.Encode) }
+func BenchmarkBase32EncoderBase64x_20B (b *testing.B) { benchmarkBase32Encoder(b, 20, xbase32.StdEncoding.Encode) }
+func BenchmarkBase32EncoderBase64x_60B (b *testing.B) { benchmarkBase32Encoder(b, 60, xbase32.StdEncoding.Encode) }
+func BenchmarkBase32EncoderBase64x_4kB (b *testing.B) { benchmarkBase32Encoder(b, 4095, xbase32.StdEncoding.Encode) }
+
+func BenchmarkBase32DecoderStdlib_20B  (b *testing.B) { benchmarkBase32Decoder(b, 20, base32.StdEncoding.Decode) }
+func BenchmarkBase32DecoderStdlib_60B  (b *testing.B) { benchmarkBase32Decoder(b, 60, base32.StdEncoding.Decode) }
+func BenchmarkBase32DecoderStdlib_4kB  (b *testing.B) { benchmarkBase32Decoder(b, 4095, base32.StdEncoding.Decode) }
+func BenchmarkBase32DecoderBase64x_20B (b *testing.B) { benchmarkBase32Decoder(b, 20, xbase32.StdEncoding.Decode) }
+func BenchmarkBase32DecoderBase64x_60B (b *testing.B) { benchmarkBase32Decoder(b, 60, xbase32.StdEncoding.Decode) }
+func BenchmarkBase32DecoderBase64x_4kB (b *testing.B) { benchmarkBase32Decoder(b, 4095, xbase32.StdEncoding.Decode) }
+
+func benchmarkTranscodeLoop(b *testing.B, nb int) {
+    buf := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    src := StdEncoding.EncodeToString(buf)
+    b.SetBytes(int64(len(src)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        for pb.Next() {
+            v, _ := StdEncoding.DecodeString(src)
+            _ = RawURLEncoding.EncodeToString(v)
+        }
+    })
+}
+
+func benchmarkTranscodeBase64x(b *testing.B, nb int) {
+    buf := make([]byte, nb)
+    _, _ = io.ReadFull(rand.Reader, buf)
+    src := []byte(StdEncoding.EncodeToString(buf))
+    b.SetBytes(int64(len(src)))
+    b.ResetTimer()
+    b.RunParallel(func(pb *testing.PB) {
+        dst := make([]byte, 0, len(src))
+        for pb.Next() {
+            _, _ = Transcode(dst, StdEncoding, RawURLEncoding, src)
+        }
+    })
+}
+
+func BenchmarkTranscodeLoop_18B     (b *testing.B) { benchmarkTranscodeLoop(b, 18) }
+func BenchmarkTranscodeLoop_36B     (b *testing.B) { benchmarkTranscodeLoop(b, 36) }
+func BenchmarkTranscodeLoop_96B     (b *testing.B) { benchmarkTranscodeLoop(b, 96) }
+func BenchmarkTranscodeLoop_3kB     (b *testing.B) { benchmarkTranscodeLoop(b, 3 * 1024) }
+func BenchmarkTranscodeBase64x_18B  (b *testing.B) { benchmarkTranscodeBase64x(b, 18) }
+func BenchmarkTranscodeBase64x_36B  (b *testing.B) { benchmarkTranscodeBase64x(b, 36) }
+func BenchmarkTranscodeBase64x_96B  (b *testing.B) { benchmarkTranscodeBase64x(b, 96) }
+func BenchmarkTranscodeBase64x_3kB  (b *testing.B) { benchmarkTranscodeBase64x(b, 3 * 1024) }
@@ -0,0 +1,184 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `encoding/json`
+    `reflect`
+)
+
+// Bytes is a []byte that is marshaled into JSON strings and texts with
+// StdEncoding, as encoding/json does for []byte, but in native code.
+//
+// JSON strings are decoded with JSONStdEncoding, so the escape sequences
+// are handled by the decoder itself. A JSON null leaves the value
+// unchanged, and invalid base64 data is reported as
+// base64.CorruptInputError, with the offset relative to the contents
+// of the string.
+type Bytes []byte
+
+// URLBytes is like Bytes, but marshaled with URLEncoding.
+type URLBytes []byte
+
+// RawBytes is like Bytes, but marshaled with RawStdEncoding.
+type RawBytes []byte
+
+// RawURLBytes is like Bytes, but marshaled with RawURLEncoding.
+type RawURLBytes []byte
+
+// MarshalJSON implements json.Marshaler.
+func (self Bytes) MarshalJSON() ([]byte, error) {
+    return marshalJSON(StdEncoding, self)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (self *Bytes) UnmarshalJSON(data []byte) error {
+    return unmarshalJSON(StdEncoding, (*[]byte)(self), data, reflect.TypeOf(self))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (self Bytes) MarshalText() ([]byte, error) {
+    return marshalText(StdEncoding, self)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (self *Bytes) UnmarshalText(text []byte) error {
+    return unmarshalText(StdEncoding, (*[]byte)(self), text)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (self URLBytes) MarshalJSON() ([]byte, error) {
+    return marshalJSON(URLEncoding, self)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (self *URLBytes) UnmarshalJSON(data []byte) error {
+    return unmarshalJSON(URLEncoding, (*[]byte)(self), data, reflect.TypeOf(self))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (self URLBytes) MarshalText() ([]byte, error) {
+    return marshalText(URLEncoding, self)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (self *URLBytes) UnmarshalText(text []byte) error {
+    return unmarshalText(URLEncoding, (*[]byte)(self), text)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (self RawBytes) MarshalJSON() ([]byte, error) {
+    return marshalJSON(RawStdEncoding, self)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (self *RawBytes) UnmarshalJSON(data []byte) error {
+    return unmarshalJSON(RawStdEncoding, (*[]byte)(self), data, reflect.TypeOf(self))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (self RawBytes) MarshalText() ([]byte, error) {
+    return marshalText(RawStdEncoding, self)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (self *RawBytes) UnmarshalText(text []byte) error {
+    return unmarshalText(RawStdEncoding, (*[]byte)(self), text)
+}
+
+// MarshalJSON implements json.Marshaler.
+func (self RawURLBytes) MarshalJSON() ([]byte, error) {
+    return marshalJSON(RawURLEncoding, self)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (self *RawURLBytes) UnmarshalJSON(data []byte) error {
+    return unmarshalJSON(RawURLEncoding, (*[]byte)(self), data, reflect.TypeOf(self))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (self RawURLBytes) MarshalText() ([]byte, error) {
+    return marshalText(RawURLEncoding, self)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (self *RawURLBytes) UnmarshalText(text []byte) error {
+    return unmarshalText(RawURLEncoding, (*[]byte)(self), text)
+}
+
+func marshalJSON(enc Encoding, src []byte) ([]byte, error) {
+    if src == nil {
+        return []byte("null"), nil
+    }
+
+    /* the base64 alphabets need no escaping */
+    ret := make([]byte, 0, enc.EncodedLen(len(src)) + 2)
+    ret = append(ret, '"')
+    enc.EncodeUnsafe(&ret, src)
+    return append(ret, '"'), nil
+}
+
+func unmarshalJSON(enc Encoding, out *[]byte, data []byte, vt reflect.Type) error {
+    if string(data) == "null" {
+        return nil
+    }
+
+    /* must be a JSON string */
+    if len(data) < 2 || data[0] != '"' || data[len(data) - 1] != '"' {
+        return &json.UnmarshalTypeError{Value: jsonKind(data), Type: vt.Elem()}
+    }
+
+    /* escaped strings are never shorter */
+    src := data[1:len(data) - 1]
+    ret := make([]byte, 0, enc.DecodedCap(len(src)))
+
+    /* decode the escape sequences along with the base64 data */
+    if _, err := (enc | _MODE_JSON).DecodeUnsafe(&ret, src); err != nil {
+        return err
+    } else {
+        *out = ret
+        return nil
+    }
+}
+
+func jsonKind(data []byte) string {
+    if len(data) == 0 {
+        return "value"
+    }
+    switch data[0] {
+        case '{'      : return "object"
+        case '['      : return "array"
+        case 't', 'f' : return "bool"
+        default       : return "number"
+    }
+}
+
+func marshalText(enc Encoding, src []byte) ([]byte, error) {
+    ret := make([]byte, 0, enc.EncodedLen(len(src)))
+    enc.EncodeUnsafe(&ret, src)
+    return ret, nil
+}
+
+func unmarshalText(enc Encoding, out *[]byte, text []byte) error {
+    ret := make([]byte, 0, enc.DecodedCap(len(text)))
+    if _, err := enc.DecodeUnsafe(&ret, text); err != nil {
+        return err
+    } else {
+        *out = ret
+        return nil
+    }
+}
@@ -0,0 +1,155 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `encoding/json`
+    `math/rand`
+    `testing`
+)
+
+func TestBytesMarshalJSON(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 256; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* compare with encoding/json */
+        want, _ := json.Marshal(src)
+        got, err := json.Marshal(Bytes(src))
+        testEqual(t, "Marshal(%x) = error %v, want %v", src, err, error(nil))
+        testEqual(t, "Marshal(%x) = %s, want %s", src, string(got), string(want))
+
+        /* decode must round-trip */
+        var dec Bytes
+        if err = json.Unmarshal(got, &dec); err != nil || !bytes.Equal(dec, src) {
+            t.Errorf("Unmarshal(%s) = %x, %v, want %x", got, dec, err, src)
+        }
+    }
+}
+
+func TestBytesVariants(t *testing.T) {
+    var v struct {
+        A Bytes       `json:"a"`
+        B URLBytes    `json:"b"`
+        C RawBytes    `json:"c"`
+        D RawURLBytes `json:"d"`
+        E Bytes       `json:"e"`
+        F Bytes       `json:"f,omitempty"`
+    }
+
+    /* all the alphabets */
+    src := []byte("\xfb\xff\xbf?")
+    v.A, v.B, v.C, v.D, v.E = src, src, src, src, Bytes{}
+    got, err := json.Marshal(v)
+    testEqual(t, "Marshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "Marshal() = %s, want %s", string(got), `{"a":"+/+/Pw==","b":"-_-_Pw==","c":"+/+/Pw","d":"-_-_Pw","e":""}`)
+
+    /* decode must round-trip */
+    v.A, v.B, v.C, v.D, v.E = nil, nil, nil, nil, nil
+    if err = json.Unmarshal(got, &v); err != nil {
+        t.Fatal(err)
+    }
+    for _, b := range [][]byte{v.A, v.B, v.C, v.D} {
+        testEqual(t, "Unmarshal() = %q, want %q", string(b), string(src))
+    }
+    if v.E == nil || len(v.E) != 0 {
+        t.Errorf("Unmarshal() = %#v, want empty", v.E)
+    }
+
+    /* null is a no-op */
+    if err = json.Unmarshal([]byte(`{"a":null}`), &v); err != nil || !bytes.Equal(v.A, src) {
+        t.Errorf("Unmarshal() = %q, %v", v.A, err)
+    }
+    got, _ = json.Marshal(struct{ A Bytes }{})
+    testEqual(t, "Marshal() = %s, want %s", string(got), `{"A":null}`)
+}
+
+func TestBytesUnmarshalEscapes(t *testing.T) {
+    for _, p := range json_pairs {
+        var dec Bytes
+        err := json.Unmarshal([]byte(`"` + p.encoded + `"`), &dec)
+        testEqual(t, "Unmarshal(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "Unmarshal(%q) = %q, want %q", p.encoded, string(dec), p.decoded)
+    }
+
+    /* escaped '/' and '_' */
+    var a Bytes
+    var b RawURLBytes
+    if err := json.Unmarshal([]byte(`"\/\/\/\/"`), &a); err != nil || string(a) != "\xff\xff\xff" {
+        t.Errorf("Unmarshal() = %q, %v", a, err)
+    }
+    if err := json.Unmarshal([]byte(`"____"`), &b); err != nil || string(b) != "\xff\xff\xff" {
+        t.Errorf("Unmarshal() = %q, %v", b, err)
+    }
+}
+
+func TestBytesUnmarshalShortPadding(t *testing.T) {
+    var a, b Bytes
+    if err := json.Unmarshal([]byte(`"Zg="`), &a); err != nil || string(a) != "f" || cap(a) < 3 {
+        t.Errorf("Unmarshal() = %q (cap %d), %v", a, cap(a), err)
+    }
+    if err := b.UnmarshalText([]byte("Zg=")); err != nil || string(b) != "f" || cap(b) < 3 {
+        t.Errorf("UnmarshalText() = %q (cap %d), %v", b, cap(b), err)
+    }
+}
+
+func TestBytesUnmarshalError(t *testing.T) {
+    var v Bytes
+    err := json.Unmarshal([]byte(`"Zm!vYg=="`), &v)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(base64.CorruptInputError(2)))
+
+    /* not a string */
+    for _, src := range []string{`1`, `true`, `{}`, `[1]`} {
+        err = json.Unmarshal([]byte(src), &v)
+        if e, ok := err.(*json.UnmarshalTypeError); !ok {
+            t.Errorf("Unmarshal(%s) = error %v, want *json.UnmarshalTypeError", src, err)
+        } else {
+            testEqual(t, "Unmarshal(%s) = type %v, want %v", src, e.Type.String(), "base64x.Bytes")
+        }
+    }
+}
+
+func TestBytesText(t *testing.T) {
+    src := []byte("\xfb\xff\xbf?")
+    for _, tt := range []struct {
+        v    interface{ MarshalText() ([]byte, error) }
+        want string
+    }{
+        {Bytes(src), "+/+/Pw=="},
+        {URLBytes(src), "-_-_Pw=="},
+        {RawBytes(src), "+/+/Pw"},
+        {RawURLBytes(src), "-_-_Pw"},
+    } {
+        got, err := tt.v.MarshalText()
+        testEqual(t, "MarshalText() = error %v, want %v", err, error(nil))
+        testEqual(t, "MarshalText() = %s, want %s", string(got), tt.want)
+    }
+
+    /* no JSON escapes in texts */
+    var v Bytes
+    err := v.UnmarshalText([]byte("+/+/Pw=="))
+    testEqual(t, "UnmarshalText() = error %v, want %v", err, error(nil))
+    testEqual(t, "UnmarshalText() = %q, want %q", string(v), string(src))
+    err = v.UnmarshalText([]byte(`\/\/\/\/`))
+    testEqual(t, "UnmarshalText() = error %v, want %v", err, error(base64.CorruptInputError(0)))
+    var u RawURLBytes
+    err = u.UnmarshalText([]byte("-_-_Pw"))
+    testEqual(t, "UnmarshalText() = %q, want %q", string(u), string(src))
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `errors`
+    `mime`
+    `net/url`
+    `strings`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+const (
+    dataURIScheme = "data:"
+    dataURIBase64 = ";base64"
+    dataURIMedia  = "text/plain"
+)
+
+// ErrDataURI is returned when a data URI is not of the form
+// "data:[<mediatype>][;base64],<data>".
+var ErrDataURI = errors.New("base64x: malformed data URI")
+
+// DataURI is a parsed RFC 2397 data URI.
+type DataURI struct {
+    // MediaType is the lower-cased media type, such as "image/png".
+    // It is "text/plain" if the URI does not specify one.
+    MediaType string
+
+    // Params holds the media type parameters, such as "charset".
+    // It is {"charset": "US-ASCII"} if the URI does not specify a
+    // media type.
+    Params map[string]string
+
+    // Base64 reports whether the payload was base64 encoded.
+    Base64 bool
+
+    // Data is the decoded payload.
+    Data []byte
+}
+
+// ParseDataURI parses an RFC 2397 data URI, decoding a base64
+// payload with StdEncoding.
+func ParseDataURI(uri string) (*DataURI, error) {
+    return StdEncoding.ParseDataURI(uri)
+}
+
+// FormatDataURI returns a base64 data URI holding data with the
+// given media type, encoded with StdEncoding.
+func FormatDataURI(mediatype string, data []byte) string {
+    return StdEncoding.FormatDataURI(mediatype, data)
+}
+
+// ParseDataURI parses an RFC 2397 data URI, decoding a base64
+// payload with the specified encoding.
+//
+// Browsers and tools disagree on the alphabet of the payloads, so the
+// URL-safe ones can be accepted along with the standard ones by a
+// lenient encoding, such as StdEncoding.Lenient(). The offset in a
+// base64.CorruptInputError is relative to the start of the payload
+// after percent-decoding.
+func (self Encoding) ParseDataURI(uri string) (*DataURI, error) {
+    if len(uri) < len(dataURIScheme) || !strings.EqualFold(uri[:len(dataURIScheme)], dataURIScheme) {
+        return nil, ErrDataURI
+    }
+
+    /* split the header and the payload */
+    uri = uri[len(dataURIScheme):]
+    idx := strings.IndexByte(uri, ',')
+
+    /* must have a comma */
+    if idx < 0 {
+        return nil, ErrDataURI
+    }
+
+    /* parse the header */
+    ret := new(DataURI)
+    hdr := uri[:idx]
+    src := uri[idx + 1:]
+
+    /* check for the base64 marker, which must be the last parameter */
+    if n := len(hdr) - len(dataURIBase64); n >= 0 && strings.EqualFold(hdr[n:], dataURIBase64) {
+        hdr = hdr[:n]
+        ret.Base64 = true
+    }
+
+    /* parse the media type */
+    if err := ret.parseMediaType(hdr); err != nil {
+        return nil, err
+    }
+
+    /* the payload may be percent-encoded */
+    if strings.IndexByte(src, '%') >= 0 {
+        if s, err := url.PathUnescape(src); err != nil {
+            return nil, ErrDataURI
+        } else {
+            src = s
+        }
+    }
+
+    /* plain-text payload */
+    if !ret.Base64 {
+        ret.Data = []byte(src)
+        return ret, nil
+    }
+
+    /* decode the payload */
+    if buf, err := self.DecodeString(src); err != nil {
+        return nil, err
+    } else {
+        ret.Data = buf
+        return ret, nil
+    }
+}
+
+// FormatDataURI returns a base64 data URI holding data with the
+// given media type, encoded with the specified encoding. The media
+// type may carry parameters, such as "text/plain;charset=utf-8", and
+// is written as-is.
+func (self Encoding) FormatDataURI(mediatype string, data []byte) string {
+    nb := len(dataURIScheme) + len(mediatype) + len(dataURIBase64) + 1
+    ret := make([]byte, 0, nb + self.EncodedLen(len(data)))
+
+    /* write the header */
+    ret = append(ret, dataURIScheme...)
+    ret = append(ret, mediatype...)
+    ret = append(ret, dataURIBase64...)
+    ret = append(ret, ',')
+
+    /* encode directly after the header */
+    self.EncodeUnsafe(&ret, data)
+    return rt.Mem2Str(ret)
+}
+
+func (self *DataURI) parseMediaType(hdr string) error {
+    if hdr == "" {
+        self.MediaType = dataURIMedia
+        self.Params = map[string]string{"charset": "US-ASCII"}
+        return nil
+    }
+
+    /* RFC 2397 allows parameters without a type, e.g. "data:;charset=utf-8,..." */
+    if hdr[0] == ';' {
+        hdr = dataURIMedia + hdr
+    }
+
+    /* use the MIME parser for the rest */
+    if mt, params, err := mime.ParseMediaType(hdr); err != nil {
+        return ErrDataURI
+    } else {
+        self.MediaType = mt
+        self.Params = params
+        return nil
+    }
+}
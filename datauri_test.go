@@ -0,0 +1,120 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `encoding/base64`
+    `reflect`
+    `testing`
+)
+
+type DataURITest struct {
+    uri    string
+    media  string
+    params map[string]string
+    b64    bool
+    data   string
+}
+
+var dataURITests = []DataURITest{
+    // RFC 2397 examples
+    {"data:,A%20brief%20note", "text/plain", map[string]string{"charset": "US-ASCII"}, false, "A brief note"},
+    {"data:text/plain;charset=iso-8859-7,%be%fg%be", "", nil, false, ""},
+    {"data:image/gif;base64,R0lGODdh", "image/gif", map[string]string{}, true, "GIF87a"},
+
+    // parameters and case folding
+    {"DATA:Text/HTML;Charset=UTF-8;BASE64,PGI+aGk8L2I+", "text/html", map[string]string{"charset": "UTF-8"}, true, "<b>hi</b>"},
+    {"data:;charset=utf-8,hello", "text/plain", map[string]string{"charset": "utf-8"}, false, "hello"},
+    {"data:;base64,aGVsbG8=", "text/plain", map[string]string{"charset": "US-ASCII"}, true, "hello"},
+    {"data:application/octet-stream;base64,", "application/octet-stream", map[string]string{}, true, ""},
+
+    // percent-encoded base64
+    {"data:text/plain;base64,PGI%2BaGk8L2I%2B", "text/plain", map[string]string{}, true, "<b>hi</b>"},
+}
+
+func TestParseDataURI(t *testing.T) {
+    for _, tt := range dataURITests {
+        ret, err := ParseDataURI(tt.uri)
+        if tt.media == "" {
+            testEqual(t, "ParseDataURI(%q) = error %v, want %v", tt.uri, err, ErrDataURI)
+            continue
+        }
+        if err != nil {
+            t.Errorf("ParseDataURI(%q) = error %v", tt.uri, err)
+            continue
+        }
+        testEqual(t, "ParseDataURI(%q).MediaType = %q, want %q", tt.uri, ret.MediaType, tt.media)
+        testEqual(t, "ParseDataURI(%q).Base64 = %v, want %v", tt.uri, ret.Base64, tt.b64)
+        testEqual(t, "ParseDataURI(%q).Data = %q, want %q", tt.uri, string(ret.Data), tt.data)
+        if !reflect.DeepEqual(ret.Params, tt.params) {
+            t.Errorf("ParseDataURI(%q).Params = %v, want %v", tt.uri, ret.Params, tt.params)
+        }
+    }
+}
+
+func TestParseDataURIError(t *testing.T) {
+    for _, uri := range []string{"", "data", "date:,abc", "data:text/plain", "data:text/plain/x;base64,"} {
+        _, err := ParseDataURI(uri)
+        testEqual(t, "ParseDataURI(%q) = error %v, want %v", uri, err, ErrDataURI)
+    }
+    _, err := ParseDataURI("data:image/png;base64,aGVs!G8=")
+    testEqual(t, "ParseDataURI() = error %v, want %v", err, error(base64.CorruptInputError(4)))
+    _, err = ParseDataURI("data:image/png;base64,-_-_")
+    testEqual(t, "ParseDataURI() = error %v, want %v", err, error(base64.CorruptInputError(0)))
+    _, err = StdEncoding.Lenient().ParseDataURI("data:image/png;base64,-_-_!A==")
+    testEqual(t, "ParseDataURI() = error %v, want %v", err, error(base64.CorruptInputError(4)))
+}
+
+func TestParseDataURIURLSafe(t *testing.T) {
+    for _, enc := range []Encoding{StdEncoding.Lenient(), URLEncoding.Lenient()} {
+        for _, src := range []string{"-_-_", "+/+/", "-_+/"} {
+            uri := "data:application/octet-stream;base64," + src
+            ret, err := enc.ParseDataURI(uri)
+            testEqual(t, "ParseDataURI(%q) = error %v, want %v", uri, err, error(nil))
+            testEqual(t, "ParseDataURI(%q).Data = %q, want %q", uri, string(ret.Data), "\xfb\xff\xbf")
+        }
+    }
+}
+
+func TestParseDataURIShortPadding(t *testing.T) {
+    ret, err := ParseDataURI("data:;base64,Zg=")
+    testEqual(t, "ParseDataURI() = error %v, want %v", err, error(nil))
+    testEqual(t, "ParseDataURI().Data = %q, want %q", string(ret.Data), "f")
+    testEqual(t, "ParseDataURI().Data = cap %v, want >= %v", cap(ret.Data) >= len(ret.Data), true)
+
+    /* the same bytes as the standard form of the payload */
+    std, err := Transcode(nil, URLEncoding, StdEncoding, []byte("ZmLLH_="))
+    testEqual(t, "Transcode() = error %v, want %v", err, error(nil))
+    want, _ := StdEncoding.DecodeString(string(std))
+    ret, err = StdEncoding.Lenient().ParseDataURI("data:;base64,ZmLLH_=")
+    testEqual(t, "ParseDataURI() = error %v, want %v", err, error(nil))
+    testEqual(t, "ParseDataURI().Data = %q, want %q", string(ret.Data), string(want))
+}
+
+func TestFormatDataURI(t *testing.T) {
+    for _, p := range pairs {
+        for _, tt := range encodingTests {
+            uri := tt.enc.FormatDataURI("image/png", []byte(p.decoded))
+            testEqual(t, "FormatDataURI(%q) = %q, want %q", p.decoded, uri, "data:image/png;base64," + tt.conv(p.encoded))
+        }
+        uri := FormatDataURI("text/plain;charset=utf-8", []byte(p.decoded))
+        ret, err := ParseDataURI(uri)
+        testEqual(t, "ParseDataURI(%q) = error %v, want %v", uri, err, error(nil))
+        testEqual(t, "ParseDataURI(%q).Data = %q, want %q", uri, string(ret.Data), p.decoded)
+        testEqual(t, "ParseDataURI(%q).Params = %q, want %q", uri, ret.Params["charset"], "utf-8")
+    }
+}
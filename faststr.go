@@ -21,19 +21,6 @@ import (
     `unsafe`
 )
 
-func mem2str(v []byte) (s string) {
-    (*reflect.StringHeader)(unsafe.Pointer(&s)).Len  = (*reflect.SliceHeader)(unsafe.Pointer(&v)).Len
-    (*reflect.StringHeader)(unsafe.Pointer(&s)).Data = (*reflect.SliceHeader)(unsafe.Pointer(&v)).Data
-    return
-}
-
-func str2mem(s string) (v []byte) {
-    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Cap  = (*reflect.StringHeader)(unsafe.Pointer(&s)).Len
-    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Len  = (*reflect.StringHeader)(unsafe.Pointer(&s)).Len
-    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Data = (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
-    return
-}
-
 func mem2addr(v []byte) unsafe.Pointer {
     return *(*unsafe.Pointer)(unsafe.Pointer(&v))
 }
@@ -49,3 +36,11 @@ func noEscape(p unsafe.Pointer) unsafe.Pointer {
     x := uintptr(p)
     return unsafe.Pointer(x ^ 0)
 }
+
+// setLen updates the length of out only. The Go versions of the codecs
+// store into the buffer of out and set the length with it, instead of
+// assigning a new slice to out, which would make every caller's output
+// buffer escape to the heap.
+func setLen(out *[]byte, n int) {
+    (*reflect.SliceHeader)(unsafe.Pointer(out)).Len = n
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `flag`
+)
+
+// A Flag is binary data given as base64 text on the command line. It
+// implements flag.Value and flag.Getter, as well as the Type method of
+// the pflag package.
+type Flag struct {
+    Data     []byte   // the decoded data
+    Encoding Encoding // the encoding of the flag, StdEncoding if zero
+    Fallback bool     // also accept the other alphabet and paddings
+    Name     string   // the name of the flag, used in errors
+}
+
+// A FlagError is returned by Flag.Set for values that are not valid
+// base64 data, Err is a base64.CorruptInputError. The message is that of
+// Err only, since flag.FlagSet already reports the name of the flag.
+type FlagError struct {
+    Name string
+    Err  error
+}
+
+func (self *FlagError) Error() string {
+    return self.Err.Error()
+}
+
+// Unwrap returns the base64.CorruptInputError.
+func (self *FlagError) Unwrap() error {
+    return self.Err
+}
+
+// NewFlag defines a flag in fs with the specified name, default value,
+// encoding and usage string, and returns it.
+func NewFlag(fs *flag.FlagSet, name string, value []byte, enc Encoding, usage string) *Flag {
+    ret := &Flag{Data: value, Encoding: enc, Name: name}
+    fs.Var(ret, name, usage)
+    return ret
+}
+
+// Set implements flag.Value. It replaces Data with the decoded value.
+//
+// With Fallback, the value may use either alphabet and be padded or
+// not, regardless of the Encoding, but must not mix the alphabets.
+func (self *Flag) Set(value string) error {
+    enc := self.Encoding
+    if self.Fallback {
+        enc = enc.LenientUnmixed().OptionalPadding()
+    }
+
+    /* decode into a new buffer, Data is not changed on errors */
+    if buf, err := enc.DecodeString(value); err != nil {
+        return &FlagError{Name: self.Name, Err: err}
+    } else {
+        self.Data = buf
+        return nil
+    }
+}
+
+// String implements flag.Value, and returns Data encoded with the
+// Encoding.
+func (self *Flag) String() string {
+    if self == nil {
+        return ""
+    } else {
+        return self.Encoding.EncodeToString(self.Data)
+    }
+}
+
+// Get implements flag.Getter, and returns Data.
+func (self *Flag) Get() interface{} {
+    return self.Data
+}
+
+// Type returns the type name of the flag, for the pflag package.
+func (self *Flag) Type() string {
+    return "base64"
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `errors`
+    `flag`
+    `io`
+    `testing`
+)
+
+func newFlagSet() *flag.FlagSet {
+    fs := flag.NewFlagSet("test", flag.ContinueOnError)
+    fs.SetOutput(io.Discard)
+    return fs
+}
+
+func TestFlag(t *testing.T) {
+    fs := newFlagSet()
+    key := NewFlag(fs, "key", nil, StdEncoding, "the key")
+    salt := NewFlag(fs, "salt", []byte("salt"), RawURLEncoding, "the salt")
+
+    /* the defaults */
+    testEqual(t, "DefValue = %q, want %q", fs.Lookup("key").DefValue, "")
+    testEqual(t, "DefValue = %q, want %q", fs.Lookup("salt").DefValue, "c2FsdA")
+
+    /* parse the flags */
+    err := fs.Parse([]string{"-key", "+/8=", "-salt=-_8"})
+    testEqual(t, "Parse() = error %v, want %v", err, error(nil))
+    testEqual(t, "key = %q, want %q", string(key.Data), "\xfb\xff")
+    testEqual(t, "salt = %q, want %q", string(salt.Data), "\xfb\xff")
+
+    /* print them back */
+    testEqual(t, "String() = %q, want %q", fs.Lookup("key").Value.String(), "+/8=")
+    testEqual(t, "String() = %q, want %q", fs.Lookup("salt").Value.String(), "-_8")
+    testEqual(t, "Get() = %q, want %q", string(fs.Lookup("key").Value.(flag.Getter).Get().([]byte)), "\xfb\xff")
+    testEqual(t, "Type() = %q, want %q", key.Type(), "base64")
+}
+
+func TestFlagFallback(t *testing.T) {
+    for _, value := range []string{"+/8=", "+/8", "-_8=", "-_8"} {
+        fs := newFlagSet()
+        key := NewFlag(fs, "key", nil, RawURLEncoding, "the key")
+        key.Fallback = true
+
+        /* any alphabet, with or without paddings */
+        err := fs.Parse([]string{"-key", value})
+        testEqual(t, "Parse(%q) = error %v, want %v", value, err, error(nil))
+        testEqual(t, "key = %q, want %q", string(key.Data), "\xfb\xff")
+
+        /* but printed with the encoding */
+        testEqual(t, "String() = %q, want %q", key.String(), "-_8")
+    }
+
+    /* mixing the alphabets is still an error */
+    err := (&Flag{Fallback: true}).Set("+_8=")
+    if fe, ok := err.(*FlagError); !ok || fe.Err != base64.CorruptInputError(1) {
+        t.Fatalf("Set() = error %v, want base64.CorruptInputError(1)", err)
+    }
+}
+
+func TestFlagShortPadding(t *testing.T) {
+    key := Flag{}
+    err := key.Set("Zm9vYg=")
+    testEqual(t, "Set() = error %v, want %v", err, error(nil))
+    testEqual(t, "Data = %q, want %q", string(key.Data), "foob")
+    testEqual(t, "Data = cap %v, want >= %v", cap(key.Data) >= len(key.Data), true)
+
+    /* the optional paddings of Fallback must be complete */
+    key = Flag{Fallback: true}
+    err = key.Set("Zm9vYg=")
+    testEqual(t, "Set() = error %v, want %v", err != nil, true)
+}
+
+func TestFlagErrors(t *testing.T) {
+    fs := newFlagSet()
+    key := NewFlag(fs, "key", []byte("key"), URLEncoding, "the key")
+
+    /* the value is rejected, and the flag is unchanged */
+    err := fs.Parse([]string{"-key", "a2V5+A=="})
+    if err == nil || err.Error() != `invalid value "a2V5+A==" for flag -key: illegal base64 data at input byte 4` {
+        t.Fatalf("Parse() = error %v", err)
+    }
+    if !bytes.Equal(key.Data, []byte("key")) {
+        t.Fatalf("key = %q, want %q", key.Data, "key")
+    }
+
+    /* the error carries the flag name and the offset */
+    var fe *FlagError
+    var ce base64.CorruptInputError
+    err = key.Set("a2V5!A==")
+    if !errors.As(err, &fe) || fe.Name != "key" || err.Error() != "illegal base64 data at input byte 4" {
+        t.Fatalf("Set() = error %v, want a FlagError", err)
+    }
+    if !errors.As(err, &ce) || ce != 4 {
+        t.Fatalf("Set() = error %v, want base64.CorruptInputError(4)", err)
+    }
+}
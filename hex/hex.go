@@ -0,0 +1,320 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package hex implements hexadecimal (base16) encoding and decoding
+// with the same API shape as base64x.Encoding.
+//
+// Inputs longer than a few words are handled by the native kernels in
+// native/hex.h, which encode 32 bytes and decode 64 characters at a time
+// with AVX2. Shorter ones are handled in Go, which encodes 8 bytes at a
+// time through a table of character pairs, and decodes 16 characters at
+// a time in two uint64 (SWAR); see the Hex cases in bench/.
+package hex
+
+import (
+    `encoding/binary`
+    `encoding/hex`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a hexadecimal encoding/decoding scheme. Encodings
+// only differ in the case of the letters they emit, decoding accepts
+// both cases.
+type Encoding int
+
+const (
+    _MODE_UPPER = 1 << 0
+)
+
+// StdEncoding is the lowercase hexadecimal encoding, as produced by
+// encoding/hex.
+const StdEncoding Encoding = 0
+
+// UpperEncoding is the uppercase hexadecimal encoding.
+const UpperEncoding Encoding = _MODE_UPPER
+
+// InvalidByteError is the error returned for a non-hexadecimal byte.
+// It is the same type as in encoding/hex.
+type InvalidByteError = hex.InvalidByteError
+
+// ErrLength is returned when decoding an odd number of characters.
+// It is the same value as in encoding/hex.
+var ErrLength = hex.ErrLength
+
+// Inputs up to these sizes are handled in Go. The thresholds are chosen
+// by BenchmarkSmall, which compares the Go versions with the native
+// calls alone. The native kernels only use the vectors from 16 bytes
+// and 32 characters, Go is faster below that.
+const (
+    _SMALL_ENCODE = 15
+    _SMALL_DECODE = 31
+)
+
+const (
+    charsetLower = "0123456789abcdef"
+    charsetUpper = "0123456789ABCDEF"
+)
+
+var (
+    encodeTableLower = makeEncodeTable(charsetLower)
+    encodeTableUpper = makeEncodeTable(charsetUpper)
+    decodeTable      = makeDecodeTable()
+)
+
+// Constants for the SWAR (SIMD within a register) decoder, which works
+// on 8 characters at a time in a uint64, in little-endian order.
+const (
+    _ONES    = 0x0101010101010101
+    _HIGHS   = 0x8080808080808080
+    _NIBBLES = 0x0f0f0f0f0f0f0f0f
+    _BYTES   = 0x00ff00ff00ff00ff
+    _WORDS   = 0x0000ffff0000ffff
+)
+
+// isHexWord checks if all the 8 characters in v are hexadecimal digits.
+func isHexWord(v uint64) bool {
+    lc := v | 0x20 * _ONES
+    dm := (v + (0x80 - '0') * _ONES) &^ (v + (0x7f - '9') * _ONES)
+    am := (lc + (0x80 - 'a') * _ONES) &^ (lc + (0x7f - 'f') * _ONES)
+    return (v & _HIGHS) == 0 && ((dm | am) & _HIGHS) == _HIGHS
+}
+
+// decodeWord decodes 8 hexadecimal digits in v into 4 bytes. Letters
+// are the digits with the 0x40 bit set, their values are 9 more than
+// their lower nibbles.
+func decodeWord(v uint64) uint32 {
+    x := (v & _NIBBLES) + (v >> 6 & _ONES) * 9
+    x = (x & _BYTES) << 4 | (x >> 8) & _BYTES
+    x = (x | x >> 8) & _WORDS
+    return uint32(x | x >> 16)
+}
+
+// makeEncodeTable makes the table of the 2 characters of every byte, in
+// little-endian order, so that 4 of them make a uint64.
+func makeEncodeTable(charset string) (tab [256]uint16) {
+    for i := range tab {
+        tab[i] = uint16(charset[i >> 4]) | uint16(charset[i & 0x0f]) << 8
+    }
+    return
+}
+
+func makeDecodeTable() (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < 16; i++ {
+        tab[charsetLower[i]] = byte(i)
+        tab[charsetUpper[i]] = byte(i)
+    }
+    return
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing
+// EncodedLen(len(src)) bytes to out.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) {
+    if len(src) != 0 {
+        if buf := out[:0:len(out)]; self.EncodedLen(len(src)) <= len(out) {
+            self.EncodeUnsafe(&buf, src)
+        } else {
+            panic("encoder output buffer is too small")
+        }
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    if len(src) <= _SMALL_ENCODE {
+        self.encodeSmall(out, src)
+    } else {
+        native.HexEncode(out, &src, int(self))
+    }
+}
+
+// encodeSmall is the Go version of the native encoder.
+func (self Encoding) encodeSmall(out *[]byte, src []byte) {
+    nb := len(*out)
+    st := &encodeTableLower
+    ret := (*out)[:nb + self.EncodedLen(len(src))]
+    buf := ret[nb:]
+
+    /* check for uppercase letters */
+    if (self & _MODE_UPPER) != 0 {
+        st = &encodeTableUpper
+    }
+
+    /* encode every 8 bytes into 16 characters */
+    for len(src) >= 8 && len(buf) >= 16 {
+        v0 := uint64(st[src[0]]) | uint64(st[src[1]]) << 16 | uint64(st[src[2]]) << 32 | uint64(st[src[3]]) << 48
+        v1 := uint64(st[src[4]]) | uint64(st[src[5]]) << 16 | uint64(st[src[6]]) << 32 | uint64(st[src[7]]) << 48
+        binary.LittleEndian.PutUint64(buf[0:], v0)
+        binary.LittleEndian.PutUint64(buf[8:], v1)
+        src = src[8:]
+        buf = buf[16:]
+    }
+
+    /* encode the remaining bytes */
+    for i, v := range src {
+        buf[i * 2 + 0] = byte(st[v])
+        buf[i * 2 + 1] = byte(st[v] >> 8)
+    }
+
+    /* update the output length */
+    *out = ret
+}
+
+// EncodeToString returns the hexadecimal encoding of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.EncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// EncodedLen returns the length in bytes of the hexadecimal encoding
+// of an input buffer of length n.
+func (self Encoding) EncodedLen(n int) int {
+    return n * 2
+}
+
+/** Decoder Functions **/
+
+// Decode decodes src using the encoding enc. It writes at most
+// DecodedLen(len(src)) bytes to out and returns the number of bytes
+// written. Both lowercase and uppercase letters are accepted.
+//
+// If src contains a non-hexadecimal byte, it will return 0 and
+// InvalidByteError. If src has an odd length and no invalid bytes,
+// it will return 0 and ErrLength.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.DecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    if len(src) <= _SMALL_DECODE {
+        return self.decodeSmall(out, src)
+    }
+
+    /* decode in native code, the error is reported at the end of src
+     * for an odd length */
+    if n := native.HexDecode(out, unsafe.Pointer(&src[0]), len(src), int(self)); n >= 0 {
+        return n, nil
+    } else if n = -n - 1; n == len(src) {
+        return 0, ErrLength
+    } else {
+        return 0, InvalidByteError(src[n])
+    }
+}
+
+// decodeSmall is the Go version of the native decoder, with the same
+// errors.
+func (self Encoding) decodeSmall(out *[]byte, src []byte) (int, error) {
+    ip := 0
+    op := 0
+    nb := len(*out)
+    buf := (*out)[nb:cap(*out)]
+
+    /* decode every 16 characters into 8 bytes */
+    for ip + 16 <= len(src) && op + 8 <= len(buf) {
+        v0 := binary.LittleEndian.Uint64(src[ip:])
+        v1 := binary.LittleEndian.Uint64(src[ip + 8:])
+
+        /* check for invalid bytes, and fallback to the slow path */
+        if !isHexWord(v0) || !isHexWord(v1) {
+            break
+        }
+
+        /* store the result, and move to next block */
+        binary.LittleEndian.PutUint32(buf[op:], decodeWord(v0))
+        binary.LittleEndian.PutUint32(buf[op + 4:], decodeWord(v1))
+        ip += 16
+        op += 8
+    }
+
+    /* decode the remaining characters one pair at a time */
+    for ; ip + 2 <= len(src); ip += 2 {
+        v0 := decodeTable[src[ip + 0]]
+        v1 := decodeTable[src[ip + 1]]
+
+        /* check for invalid bytes */
+        if v0 == 0xff {
+            return 0, InvalidByteError(src[ip + 0])
+        } else if v1 == 0xff {
+            return 0, InvalidByteError(src[ip + 1])
+        }
+
+        /* store the result */
+        buf[op] = v0 << 4 | v1
+        op++
+    }
+
+    /* check for the odd character, invalid bytes take precedence */
+    if ip != len(src) {
+        if decodeTable[src[ip]] == 0xff {
+            return 0, InvalidByteError(src[ip])
+        } else {
+            return 0, ErrLength
+        }
+    }
+
+    /* update the output length */
+    *out = (*out)[:nb + op]
+    return op, nil
+}
+
+// DecodeString returns the bytes represented by the hexadecimal string s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.DecodedLen(len(s)))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// DecodedLen returns the length in bytes of the decoded data
+// corresponding to n bytes of hexadecimal data.
+func (self Encoding) DecodedLen(n int) int {
+    return n / 2
+}
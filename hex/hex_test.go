@@ -0,0 +1,280 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package hex
+
+import (
+    `bytes`
+    `encoding/hex`
+    `math/rand`
+    `strings`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/native/sse`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+var pairs = []TestPair{
+    {"", ""},
+    {"\x00", "00"},
+    {"\x01\x23\x45\x67\x89\xab\xcd\xef", "0123456789abcdef"},
+    {"\xfe\xdc\xba\x98\x76\x54\x32\x10\xff", "fedcba9876543210ff"},
+    {"Hello, Gopher!", "48656c6c6f2c20476f7068657221"},
+    {"\xe3\xa1", "e3a1"},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestEncoder(t *testing.T) {
+    for _, p := range pairs {
+        got := StdEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, p.encoded)
+        got = UpperEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, strings.ToUpper(p.encoded))
+    }
+}
+
+func TestDecoder(t *testing.T) {
+    for _, p := range pairs {
+        for _, encoded := range []string{p.encoded, strings.ToUpper(p.encoded)} {
+            dbuf := make([]byte, StdEncoding.DecodedLen(len(encoded)))
+            count, err := StdEncoding.Decode(dbuf, []byte(encoded))
+            testEqual(t, "Decode(%q) = error %v, want %v", encoded, err, error(nil))
+            testEqual(t, "Decode(%q) = length %v, want %v", encoded, count, len(p.decoded))
+            testEqual(t, "Decode(%q) = %q, want %q", encoded, string(dbuf[0:count]), p.decoded)
+
+            dbuf, err = UpperEncoding.DecodeString(encoded)
+            testEqual(t, "DecodeString(%q) = error %v, want %v", encoded, err, error(nil))
+            testEqual(t, "DecodeString(%q) = %q, want %q", encoded, string(dbuf), p.decoded)
+        }
+    }
+}
+
+func TestDecoderError(t *testing.T) {
+    for _, src := range []string{
+        "0", "zd4aa", "d4aaz", "30313", "0g", "00gg", "0\x01",
+        "ffeed", "0123456789abcdefg", "0123456789abcdef0g", "0123456789abcdef0",
+        "\xff\xff\xff\xff\xff\xff\xff\xff", "0123456789abcdeX0123456789abcdef",
+    } {
+        _, want := hex.DecodeString(src)
+        _, err := StdEncoding.DecodeString(src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, want)
+    }
+}
+
+func TestStdlibCompatible(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 256; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* encode must be identical */
+        got := StdEncoding.EncodeToString(src)
+        want := hex.EncodeToString(src)
+        testEqual(t, "EncodeToString(%x) = %q, want %q", src, got, want)
+
+        /* decode must round-trip in both cases */
+        for _, s := range []string{want, strings.ToUpper(want)} {
+            dec, err := StdEncoding.DecodeString(s)
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("DecodeString(%q) = %x, %v, want %x", s, dec, err, src)
+            }
+        }
+    }
+}
+
+func TestEncoderEveryByte(t *testing.T) {
+    src := make([]byte, 256)
+    for i := range src {
+        src[i] = byte(i)
+    }
+    for i := 0; i < 16; i++ {
+        want := hex.EncodeToString(src[i:])
+        testEqual(t, "EncodeToString(%x) = %q, want %q", src[i:], StdEncoding.EncodeToString(src[i:]), want)
+        testEqual(t, "EncodeToString(%x) = %q, want %q", src[i:], UpperEncoding.EncodeToString(src[i:]), strings.ToUpper(want))
+    }
+}
+
+func TestDecoderEveryByte(t *testing.T) {
+    src := []byte("0123456789abcdefABCDEF9876543210")
+
+    /* every byte at every position of the blocks */
+    for i := range src {
+        for c := 0; c < 256; c++ {
+            buf := append([]byte(nil), src...)
+            buf[i] = byte(c)
+            want, werr := hex.DecodeString(string(buf))
+            got, err := StdEncoding.DecodeString(string(buf))
+            testEqual(t, "DecodeString(%q) = error %v, want %v", buf, err, werr)
+            if werr == nil {
+                testEqual(t, "DecodeString(%q) = %x, want %x", buf, string(got), string(want))
+            }
+        }
+    }
+}
+
+type kernel struct {
+    name   string
+    encode func(out *[]byte, src *[]byte, mode int)
+    decode func(out *[]byte, src unsafe.Pointer, len int, mode int) int
+}
+
+// kernels returns the kernels selected for this CPU, and the SSE ones,
+// which are loaded if needed.
+func kernels() []kernel {
+    if sse.S_hexencode == 0 {
+        sse.Use()
+    }
+    return []kernel{
+        {"native", native.HexEncode, native.HexDecode},
+        {"sse", sse.Hexencode, sse.Hexdecode},
+    }
+}
+
+func TestEncodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, enc := range []Encoding{StdEncoding, UpperEncoding} {
+        for n := 0; n <= 160; n++ {
+            for i := 0; i < 20; i++ {
+                src := make([]byte, n)
+                rng.Read(src)
+
+                /* compare with the native encoders */
+                got := append([]byte("prefix"), make([]byte, enc.EncodedLen(n))...)[:6]
+                enc.encodeSmall(&got, src)
+                for _, k := range kernels() {
+                    want := append([]byte("prefix"), make([]byte, enc.EncodedLen(n))...)[:6]
+                    k.encode(&want, &src, int(enc))
+                    testEqual(t, "encodeSmall(%x) = %q, want %q (" + k.name + ")", src, string(got), string(want))
+                }
+            }
+        }
+    }
+}
+
+func TestDecodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "09afAFgG/:@`\x00\xff"
+    for n := 1; n <= 160; n++ {
+        for i := 0; i < 200; i++ {
+            buf := make([]byte, rng.Intn(n / 2 + 1))
+            rng.Read(buf)
+            src := []byte(UpperEncoding.EncodeToString(buf))
+
+            /* mix the cases, and break some of the inputs */
+            for j := range src {
+                if rng.Intn(2) == 0 {
+                    src[j] = strings.ToLower(string(src[j]))[0]
+                }
+            }
+            for j := rng.Intn(4); j > 0 && len(src) != 0 && i % 2 == 0; j-- {
+                src[rng.Intn(len(src))] = alphabet[rng.Intn(len(alphabet))]
+            }
+            if i % 3 == 0 {
+                src = append(src, alphabet[rng.Intn(len(alphabet))])
+            }
+            if len(src) == 0 {
+                continue
+            }
+
+            /* compare with the native decoders */
+            got := make([]byte, 6, 6 + len(src))
+            gn, gerr := StdEncoding.decodeSmall(&got, src)
+            for _, k := range kernels() {
+                want := make([]byte, 6, 6 + len(src))
+                wn := k.decode(&want, unsafe.Pointer(&src[0]), len(src), int(StdEncoding))
+                switch {
+                    case wn >= 0:
+                        testEqual(t, "decodeSmall(%q) = error %v, want %v (" + k.name + ")", src, gerr, error(nil))
+                        testEqual(t, "decodeSmall(%q) = %d, want %d (" + k.name + ")", src, gn, wn)
+                        testEqual(t, "decodeSmall(%q) = %q, want %q (" + k.name + ")", src, string(got), string(want))
+                    case -wn - 1 == len(src):
+                        testEqual(t, "decodeSmall(%q) = error %v, want %v (" + k.name + ")", src, gerr, ErrLength)
+                    default:
+                        testEqual(t, "decodeSmall(%q) = error %v, want %v (" + k.name + ")", src, gerr, error(InvalidByteError(src[-wn - 1])))
+                }
+            }
+        }
+    }
+}
+
+func benchmarkEncodeSmall(b *testing.B, n int, asm bool) {
+    src := make([]byte, n)
+    buf := make([]byte, 0, StdEncoding.EncodedLen(n))
+    rand.New(rand.NewSource(0)).Read(src)
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.HexEncode(&out, &src, int(StdEncoding))
+        } else {
+            StdEncoding.encodeSmall(&out, src)
+        }
+    }
+}
+
+func benchmarkDecodeSmall(b *testing.B, n int, asm bool) {
+    raw := make([]byte, n / 2)
+    buf := make([]byte, 0, len(raw))
+    rand.New(rand.NewSource(0)).Read(raw)
+    src := []byte(StdEncoding.EncodeToString(raw))
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.HexDecode(&out, unsafe.Pointer(&src[0]), len(src), int(StdEncoding))
+        } else {
+            _, _ = StdEncoding.decodeSmall(&out, src)
+        }
+    }
+}
+
+func BenchmarkEncodeSmallGo_8B      (b *testing.B) { benchmarkEncodeSmall(b, 8, false) }
+func BenchmarkEncodeSmallGo_16B     (b *testing.B) { benchmarkEncodeSmall(b, 16, false) }
+func BenchmarkEncodeSmallGo_24B     (b *testing.B) { benchmarkEncodeSmall(b, 24, false) }
+func BenchmarkEncodeSmallGo_32B     (b *testing.B) { benchmarkEncodeSmall(b, 32, false) }
+func BenchmarkEncodeSmallGo_64B     (b *testing.B) { benchmarkEncodeSmall(b, 64, false) }
+func BenchmarkEncodeSmallNative_8B  (b *testing.B) { benchmarkEncodeSmall(b, 8, true) }
+func BenchmarkEncodeSmallNative_16B (b *testing.B) { benchmarkEncodeSmall(b, 16, true) }
+func BenchmarkEncodeSmallNative_24B (b *testing.B) { benchmarkEncodeSmall(b, 24, true) }
+func BenchmarkEncodeSmallNative_32B (b *testing.B) { benchmarkEncodeSmall(b, 32, true) }
+func BenchmarkEncodeSmallNative_64B (b *testing.B) { benchmarkEncodeSmall(b, 64, true) }
+
+func BenchmarkDecodeSmallGo_16B     (b *testing.B) { benchmarkDecodeSmall(b, 16, false) }
+func BenchmarkDecodeSmallGo_32B     (b *testing.B) { benchmarkDecodeSmall(b, 32, false) }
+func BenchmarkDecodeSmallGo_48B     (b *testing.B) { benchmarkDecodeSmall(b, 48, false) }
+func BenchmarkDecodeSmallGo_64B     (b *testing.B) { benchmarkDecodeSmall(b, 64, false) }
+func BenchmarkDecodeSmallGo_128B    (b *testing.B) { benchmarkDecodeSmall(b, 128, false) }
+func BenchmarkDecodeSmallNative_16B (b *testing.B) { benchmarkDecodeSmall(b, 16, true) }
+func BenchmarkDecodeSmallNative_32B (b *testing.B) { benchmarkDecodeSmall(b, 32, true) }
+func BenchmarkDecodeSmallNative_48B (b *testing.B) { benchmarkDecodeSmall(b, 48, true) }
+func BenchmarkDecodeSmallNative_64B (b *testing.B) { benchmarkDecodeSmall(b, 64, true) }
+func BenchmarkDecodeSmallNative_128B(b *testing.B) { benchmarkDecodeSmall(b, 128, true) }
@@ -0,0 +1,246 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package imaputf7 implements the modified UTF-7 encoding of IMAP
+// mailbox names, as defined in RFC 3501 section 5.1.3.
+//
+// Printable US-ASCII characters other than '&' represent themselves,
+// '&' is written as "&-", and everything else is shifted into
+// "&<base64>-", where <base64> is the UTF-16BE form of the characters
+// in unpadded base64, with ',' used instead of '/'.
+package imaputf7
+
+import (
+    `strconv`
+    `unicode/utf16`
+    `unicode/utf8`
+
+    `github.com/cloudwego/base64x`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// A SyntaxError describes a malformed mailbox name, and the offset of
+// the character or the shift sequence that caused it.
+type SyntaxError struct {
+    Offset int
+    Msg    string
+}
+
+func (self *SyntaxError) Error() string {
+    return "imaputf7: " + self.Msg + " at input byte " + strconv.Itoa(self.Offset)
+}
+
+const (
+    _SHIFT_BEGIN = '&'
+    _SHIFT_END   = '-'
+)
+
+func isDirect(c byte) bool {
+    return c >= 0x20 && c <= 0x7e
+}
+
+/** Encoder Functions **/
+
+// Encode returns the modified UTF-7 encoding of the UTF-8 string s.
+// Invalid UTF-8 sequences are encoded as U+FFFD.
+func Encode(s string) string {
+    ret := make([]byte, 0, len(s) + len(s) / 2)
+
+    /* encode every run of characters */
+    for i := 0; i < len(s); {
+        if c := s[i]; c == _SHIFT_BEGIN {
+            ret = append(ret, _SHIFT_BEGIN, _SHIFT_END)
+            i++
+        } else if isDirect(c) {
+            ret = append(ret, c)
+            i++
+        } else {
+            ret, i = encodeShifted(ret, s, i)
+        }
+    }
+    return rt.Mem2Str(ret)
+}
+
+// encodeShifted encodes the run of non-printable characters starting at
+// s[i] as one shift sequence, and returns the index following the run.
+func encodeShifted(out []byte, s string, i int) ([]byte, int) {
+    var u16 []uint16
+
+    /* convert into UTF-16 */
+    for i < len(s) && !isDirect(s[i]) {
+        r, n := utf8.DecodeRuneInString(s[i:])
+        if r1, r2 := utf16.EncodeRune(r); r1 == utf8.RuneError {
+            u16 = append(u16, uint16(r))
+        } else {
+            u16 = append(u16, uint16(r1), uint16(r2))
+        }
+        i += n
+    }
+
+    /* in big-endian */
+    buf := make([]byte, len(u16) * 2)
+    for j, v := range u16 {
+        buf[j * 2 + 0] = byte(v >> 8)
+        buf[j * 2 + 1] = byte(v)
+    }
+
+    /* make sure the output is large enough for EncodeUnsafe */
+    nb := base64x.RawStdEncoding.EncodedLen(len(buf))
+    if cap(out) - len(out) < nb + 2 {
+        ret := make([]byte, len(out), len(out) + nb + 2 + len(s) - i)
+        copy(ret, out)
+        out = ret
+    }
+
+    /* encode with the ',' alphabet */
+    out = append(out, _SHIFT_BEGIN)
+    op := len(out)
+    base64x.RawStdEncoding.EncodeUnsafe(&out, buf)
+
+    /* swap the alphabet */
+    for j := op; j < len(out); j++ {
+        if out[j] == '/' {
+            out[j] = ','
+        }
+    }
+    return append(out, _SHIFT_END), i
+}
+
+/** Decoder Functions **/
+
+// Decode returns the UTF-8 form of the modified UTF-7 string s.
+//
+// It returns a *SyntaxError for characters outside of printable
+// US-ASCII, unterminated shift sequences, invalid base64, incomplete
+// or unpaired UTF-16, and printable US-ASCII characters that are
+// shifted although they must represent themselves.
+func Decode(s string) (string, error) {
+    var err error
+    var buf []byte
+    ret := make([]byte, 0, len(s))
+
+    /* decode every run of characters */
+    for i := 0; i < len(s); {
+        c := s[i]
+
+        /* only printable characters are allowed */
+        if !isDirect(c) {
+            return "", &SyntaxError{i, "invalid character " + strconv.QuoteRune(rune(c))}
+        }
+
+        /* characters that represent themselves */
+        if c != _SHIFT_BEGIN {
+            ret = append(ret, c)
+            i++
+            continue
+        }
+
+        /* find the end of the shift sequence */
+        n := 1
+        for i + n < len(s) && s[i + n] != _SHIFT_END {
+            n++
+        }
+
+        /* must be terminated */
+        if i + n == len(s) {
+            return "", &SyntaxError{i, "unterminated shift sequence"}
+        }
+
+        /* "&-" is '&' */
+        if n == 1 {
+            ret = append(ret, _SHIFT_BEGIN)
+            i += 2
+            continue
+        }
+
+        /* decode the base64 part */
+        if ret, buf, err = decodeShifted(ret, buf, s, i + 1, i + n); err != nil {
+            return "", err
+        }
+        i += n + 1
+    }
+    return rt.Mem2Str(ret), nil
+}
+
+// decodeShifted decodes s[p:q], which is the base64 part of a shift
+// sequence, and appends the characters to out. buf is reused to hold
+// the base64 and UTF-16 data.
+func decodeShifted(out []byte, buf []byte, s string, p int, q int) ([]byte, []byte, error) {
+    nb := q - p
+    ns := base64x.RawStdEncoding.DecodedLen(nb)
+
+    /* the scratch buffer holds the base64 and UTF-16 data */
+    if cap(buf) < nb + ns {
+        buf = make([]byte, 0, nb + ns)
+    }
+
+    /* map the ',' alphabet to the standard one, rejecting anything else,
+     * since the base64 decoder also accepts newlines and '/' */
+    src := buf[:nb]
+    for i := 0; i < nb; i++ {
+        switch c := s[p + i]; {
+            case c == ','    : src[i] = '/'
+            case isBase64(c) : src[i] = c
+            default          : return nil, nil, &SyntaxError{p + i, "invalid base64 character " + strconv.QuoteRune(rune(c))}
+        }
+    }
+
+    /* decode with the raw standard encoding, only the length and the
+     * trailing bits can be wrong at this point */
+    dst := buf[nb:nb:nb + ns]
+    if _, err := base64x.RawStdEncoding.DecodeUnsafe(&dst, src); err != nil {
+        return nil, nil, &SyntaxError{p - 1, "invalid base64"}
+    }
+
+    /* must be a sequence of UTF-16 code units */
+    if len(dst) % 2 != 0 {
+        return nil, nil, &SyntaxError{p - 1, "incomplete UTF-16 code unit"}
+    }
+
+    /* convert into UTF-8 */
+    for i := 0; i < len(dst); i += 2 {
+        r := rune(dst[i]) << 8 | rune(dst[i + 1])
+
+        /* printable characters must represent themselves */
+        if r < utf8.RuneSelf && isDirect(byte(r)) {
+            return nil, nil, &SyntaxError{p - 1, "shifted printable character " + strconv.QuoteRune(r)}
+        }
+
+        /* must be a valid surrogate pair */
+        if utf16.IsSurrogate(r) {
+            if r >= 0xdc00 || i + 4 > len(dst) {
+                return nil, nil, &SyntaxError{p - 1, "unpaired surrogate"}
+            }
+            if r = utf16.DecodeRune(r, rune(dst[i + 2]) << 8 | rune(dst[i + 3])); r == utf8.RuneError {
+                return nil, nil, &SyntaxError{p - 1, "unpaired surrogate"}
+            }
+            i += 2
+        }
+
+        /* append the character */
+        out = appendRune(out, r)
+    }
+    return out, buf, nil
+}
+
+func isBase64(c byte) bool {
+    return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '+'
+}
+
+func appendRune(out []byte, r rune) []byte {
+    var tmp [utf8.UTFMax]byte
+    return append(out, tmp[:utf8.EncodeRune(tmp[:], r)]...)
+}
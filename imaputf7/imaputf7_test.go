@@ -0,0 +1,124 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package imaputf7
+
+import (
+    `math/rand`
+    `testing`
+    `unicode/utf8`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+var pairs = []TestPair{
+    // RFC 3501 section 5.1.3
+    {"~peter/mail/台北/日本語", "~peter/mail/&U,BTFw-/&ZeVnLIqe-"},
+    {"Hi Mom -☺-!", "Hi Mom -&Jjo--!"},
+
+    // edge cases
+    {"", ""},
+    {"&", "&-"},
+    {"&&", "&-&-"},
+    {"a\tb", "a&AAk-b"},
+    {"\x7f", "&AH8-"},
+    {"😀", "&2D3eAA-"},
+    {"台&北", "&U,A-&-&Uxc-"},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestEncode(t *testing.T) {
+    for _, p := range pairs {
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, Encode(p.decoded), p.encoded)
+    }
+    testEqual(t, "Encode(%q) = %q, want %q", "\xff", Encode("\xff"), "&,,0-")
+}
+
+func TestDecode(t *testing.T) {
+    for _, p := range pairs {
+        got, err := Decode(p.encoded)
+        testEqual(t, "Decode(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "Decode(%q) = %q, want %q", p.encoded, got, p.decoded)
+    }
+}
+
+func TestDecodeError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        pos int
+    }{
+        {"&", 0},
+        {"a&AGE", 1},
+        {"a\tb", 1},
+        {"é", 0},
+        {"&AGE-", 0},
+        {"x&Jj!-", 4},
+        {"x&Jj/-", 4},
+        {"&Jj=-", 3},
+        {"x&A-", 1},
+        {"x&AB-", 1},
+        {"&AA-", 0},
+        {"a&2D0-", 1},
+        {"&3gA-", 0},
+        {"&2D3YPQ-", 0},
+    } {
+        _, err := Decode(tt.src)
+        if e, ok := err.(*SyntaxError); !ok || e.Offset != tt.pos {
+            t.Errorf("Decode(%q) = error %v, want offset %d", tt.src, err, tt.pos)
+        }
+    }
+}
+
+func TestRoundTrip(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 256; n++ {
+        buf := make([]rune, n)
+        for i := range buf {
+            switch rng.Intn(4) {
+                case 0  : buf[i] = rune(0x20 + rng.Intn(0x5f))
+                case 1  : buf[i] = rune(rng.Intn(0x80))
+                case 2  : buf[i] = rune(0x80 + rng.Intn(0xd000))
+                default : buf[i] = rune(0x10000 + rng.Intn(0x100000))
+            }
+        }
+
+        /* encode must produce printable ASCII only */
+        src := string(buf)
+        enc := Encode(src)
+        for i := 0; i < len(enc); i++ {
+            if enc[i] < 0x20 || enc[i] >= utf8.RuneSelf {
+                t.Fatalf("Encode(%q) = %q, invalid character at %d", src, enc, i)
+            }
+        }
+
+        /* decode must round-trip */
+        dec, err := Decode(enc)
+        if err != nil || dec != src {
+            t.Errorf("Decode(%q) = %q, %v, want %q", enc, dec, err, src)
+        }
+    }
+}
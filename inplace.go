@@ -0,0 +1,74 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+// Inputs are encoded in place by blocks of this many bytes, which is a
+// multiple of 3, so only the last block has paddings.
+const (
+    _INPLACE_BLOCK = 3 * 1024
+)
+
+// DecodeInPlace decodes buf onto itself, and returns the decoded data,
+// which is a prefix of buf. On error, it returns base64.CorruptInputError
+// and the content of buf is undefined.
+//
+// This is safe because both the native and the Go decoders load every
+// block of input before storing its output, and the output never gets
+// ahead of the input: the SIMD loop stores 32 bytes for 32 characters
+// loaded, the scalar loops store 8 or 4 bytes for as many characters
+// loaded, and the tail stores 3 bytes at most for 4 characters or more
+// consumed. Skipped new lines and JSON escapes only make the output fall
+// further behind.
+func (self Encoding) DecodeInPlace(buf []byte) ([]byte, error) {
+    out := buf[:0:len(buf)]
+    if _, err := self.DecodeUnsafe(&out, buf); err != nil {
+        return nil, err
+    } else {
+        return out, nil
+    }
+}
+
+// EncodeInPlace encodes the first n bytes of buf onto buf itself, and
+// returns the encoded data, which is buf[:EncodedLen(n)].
+//
+// The blocks of input are encoded back-to-front through a small buffer
+// on the stack, since the encoded form of every block starts at or after
+// the block itself, and never overlaps with the blocks before it.
+//
+// If the capacity of buf is less than EncodedLen(n), it will panic.
+func (self Encoding) EncodeInPlace(buf []byte, n int) []byte {
+    var tmp [_INPLACE_BLOCK / 3 * 4]byte
+    ne := self.EncodedLen(n)
+
+    /* check for the output size */
+    if ne > cap(buf) {
+        panic("encoder output buffer is too small")
+    }
+
+    /* encode onto the whole buffer */
+    ret := buf[:ne]
+
+    /* the last block may be partial */
+    for j := n; j > 0; {
+        i := (j - 1) / _INPLACE_BLOCK * _INPLACE_BLOCK
+        out := tmp[:0]
+        self.EncodeUnsafe(&out, ret[i:j])
+        copy(ret[i / 3 * 4:], out)
+        j = i
+    }
+    return ret
+}
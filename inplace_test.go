@@ -0,0 +1,160 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+    `math/rand`
+    `strings`
+    `testing`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/native/sse`
+)
+
+func TestDecodeInPlace(t *testing.T) {
+    testDecodeInPlace(t)
+}
+
+// The SSE kernel has the scalar loops only, which store the output at a
+// different pace than the SIMD loop of the AVX2 one.
+func TestDecodeInPlaceSSE(t *testing.T) {
+    if sse.S_b64decode == 0 {
+        sse.Use()
+    }
+
+    /* swap the decoder for the SSE one */
+    fn := native.F_b64decode
+    native.F_b64decode = sse.F_b64decode
+    defer func() { native.F_b64decode = fn }()
+    testDecodeInPlace(t)
+    TestDecodeInPlaceError(t)
+}
+
+func testDecodeInPlace(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    modes := append([]Encoding{
+        StdEncoding.Lenient(),
+        RawURLEncoding.Lenient(),
+        StdEncoding.OptionalPadding(),
+        URLEncoding.Lenient().OptionalPadding(),
+    }, smallModes...)
+
+    for n := 0; n < 1024; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+        for _, enc := range modes {
+            /* every input length, with and without new lines */
+            encoded := enc.EncodeToString(src)
+            inputs := []string{encoded, strings.Join(strings.SplitAfter(encoded, "P"), "\r\n")}
+
+            /* with both alphabets, and without paddings */
+            if enc.IsLenient() {
+                inputs = append(inputs, strings.Replace(strings.Replace(encoded, "+", "-", 1), "_", "/", 1))
+            }
+            if enc.IsPaddingOptional() {
+                inputs = append(inputs, strings.TrimRight(encoded, "="))
+            }
+
+            /* decode onto the inputs themselves */
+            for _, in := range inputs {
+                buf := []byte(in)
+                dec, err := enc.DecodeInPlace(buf)
+                if err != nil || !bytes.Equal(dec, src) {
+                    t.Fatalf("DecodeInPlace(%q) = %x, %v, want %x", in, dec, err, src)
+                }
+                if len(dec) != 0 && &dec[0] != &buf[0] {
+                    t.Fatalf("DecodeInPlace(%q) is not in place", in)
+                }
+            }
+        }
+    }
+
+    /* mixed alphabets are decoded in place too */
+    buf := make([]byte, 8)
+    if n := testing.AllocsPerRun(100, func() { _, _ = StdEncoding.Lenient().DecodeInPlace(append(buf[:0], "Zm9v-/+_"...)) }); n != 0 {
+        t.Fatalf("DecodeInPlace(): %v allocations, want 0", n)
+    }
+}
+
+func TestDecodeInPlaceTables(t *testing.T) {
+    for _, p := range crlf_pairs {
+        dec, err := StdEncoding.DecodeInPlace([]byte(p.encoded))
+        testEqual(t, "DecodeInPlace(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "DecodeInPlace(%q) = %q, want %q", p.encoded, string(dec), p.decoded)
+    }
+    for _, p := range json_pairs {
+        dec, err := JSONStdEncoding.DecodeInPlace([]byte(p.encoded))
+        testEqual(t, "DecodeInPlace(%q) = error %v, want %v", p.encoded, err, error(nil))
+        testEqual(t, "DecodeInPlace(%q) = %q, want %q", p.encoded, string(dec), p.decoded)
+    }
+}
+
+func TestDecodeInPlaceError(t *testing.T) {
+    for _, enc := range smallModes {
+        for n := 1; n < 200; n++ {
+            buf := []byte(enc.EncodeToString(make([]byte, n)))
+            buf[len(buf) / 2] = '!'
+            _, want := enc.DecodeString(string(buf))
+            _, err := enc.DecodeInPlace(buf)
+            testEqual(t, "DecodeInPlace(%q) = error %v, want %v", buf, err, want)
+        }
+    }
+}
+
+func TestEncodeInPlace(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    sizes := []int{_INPLACE_BLOCK * 5, _INPLACE_BLOCK * 7 + 1, _INPLACE_BLOCK * 7 + 2}
+    for n := 0; n < 512; n++ {
+        sizes = append(sizes, n)
+    }
+    for n := _INPLACE_BLOCK - 4; n < _INPLACE_BLOCK * 2 + 4; n++ {
+        sizes = append(sizes, n)
+    }
+
+    /* every size modulo 3, around the block sizes */
+    for _, n := range sizes {
+        src := make([]byte, n)
+        rng.Read(src)
+        for _, enc := range smallModes {
+            buf := make([]byte, n, enc.EncodedLen(n))
+            copy(buf, src)
+            got := enc.EncodeInPlace(buf, n)
+            if string(got) != enc.EncodeToString(src) {
+                t.Fatalf("EncodeInPlace(%d bytes) mismatch for %d", n, enc)
+            }
+            if len(got) != 0 && &got[0] != &buf[:1][0] {
+                t.Fatalf("EncodeInPlace(%d bytes) is not in place", n)
+            }
+        }
+    }
+
+    /* the block buffer stays on the stack */
+    buf := make([]byte, 8)
+    if n := testing.AllocsPerRun(100, func() { StdEncoding.EncodeInPlace(buf, 4) }); n != 0 {
+        t.Fatalf("EncodeInPlace(): %v allocations, want 0", n)
+    }
+}
+
+func TestEncodeInPlacePanics(t *testing.T) {
+    defer func() {
+        if recover() == nil {
+            t.Errorf("expected panic")
+        }
+    }()
+    StdEncoding.EncodeInPlace(make([]byte, 3, 3), 3)
+}
@@ -17,29 +17,71 @@ var (
 var (
 	S_b64decode uintptr
 	S_b64encode uintptr
+	S_b64decbatch uintptr
+	S_b64encbatch uintptr
+	S_b64transcode uintptr
+	S_b32decode uintptr
+	S_b32encode uintptr
+	S_hexdecode uintptr
+	S_hexencode uintptr
 )
 
 var (
 	F_b64decode func(out unsafe.Pointer, src unsafe.Pointer, len int, mod int) (ret int)
 	F_b64encode func(out unsafe.Pointer, src unsafe.Pointer, mod int)
+	F_b64decbatch func(out unsafe.Pointer, srcs unsafe.Pointer, nb int, offs unsafe.Pointer, mod int) (ret int)
+	F_b64encbatch func(out unsafe.Pointer, srcs unsafe.Pointer, nb int, offs unsafe.Pointer, mod int)
+	F_b64transcode func(out unsafe.Pointer, src unsafe.Pointer, len int, from int, to int) (ret int)
+	F_b32decode func(out unsafe.Pointer, src unsafe.Pointer, len int, mod int) (ret int)
+	F_b32encode func(out unsafe.Pointer, src unsafe.Pointer, mod int)
+	F_hexdecode func(out unsafe.Pointer, src unsafe.Pointer, len int, mod int) (ret int)
+	F_hexencode func(out unsafe.Pointer, src unsafe.Pointer, mod int)
 )
 
 func useAVX2() {
 	avx2.Use()
 	S_b64decode = avx2.S_b64decode
 	S_b64encode = avx2.S_b64encode
+	S_b64decbatch = avx2.S_b64decbatch
+	S_b64encbatch = avx2.S_b64encbatch
+	S_b64transcode = avx2.S_b64transcode
+	S_b32decode = avx2.S_b32decode
+	S_b32encode = avx2.S_b32encode
+	S_hexdecode = avx2.S_hexdecode
+	S_hexencode = avx2.S_hexencode
 
 	F_b64decode = avx2.F_b64decode
 	F_b64encode = avx2.F_b64encode
+	F_b64decbatch = avx2.F_b64decbatch
+	F_b64encbatch = avx2.F_b64encbatch
+	F_b64transcode = avx2.F_b64transcode
+	F_b32decode = avx2.F_b32decode
+	F_b32encode = avx2.F_b32encode
+	F_hexdecode = avx2.F_hexdecode
+	F_hexencode = avx2.F_hexencode
 }
 
 func useSSE() {
 	sse.Use()
 	S_b64decode = sse.S_b64decode
 	S_b64encode = sse.S_b64encode
+	S_b64decbatch = sse.S_b64decbatch
+	S_b64encbatch = sse.S_b64encbatch
+	S_b64transcode = sse.S_b64transcode
+	S_b32decode = sse.S_b32decode
+	S_b32encode = sse.S_b32encode
+	S_hexdecode = sse.S_hexdecode
+	S_hexencode = sse.S_hexencode
 
 	F_b64decode = sse.F_b64decode
 	F_b64encode = sse.F_b64encode
+	F_b64decbatch = sse.F_b64decbatch
+	F_b64encbatch = sse.F_b64encbatch
+	F_b64transcode = sse.F_b64transcode
+	F_b32decode = sse.F_b32decode
+	F_b32encode = sse.F_b32encode
+	F_hexdecode = sse.F_hexdecode
+	F_hexencode = sse.F_hexencode
 }
 
 //go:nosplit
@@ -52,6 +94,41 @@ func B64Encode(out *[]byte, src *[]byte, mod int) {
 	F_b64encode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), mod)
 }
 
+//go:nosplit
+func B64DecodeBatch(out *[]byte, srcs unsafe.Pointer, nb int, offs unsafe.Pointer, mod int) (ret int) {
+    return F_b64decbatch(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(srcs), nb, rt.NoEscape(offs), mod)
+}
+
+//go:nosplit
+func B64EncodeBatch(out *[]byte, srcs unsafe.Pointer, nb int, offs unsafe.Pointer, mod int) {
+	F_b64encbatch(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(srcs), nb, rt.NoEscape(offs), mod)
+}
+
+//go:nosplit
+func B64Transcode(out *[]byte, src unsafe.Pointer, len int, from int, to int) (ret int) {
+    return F_b64transcode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), len, from, to)
+}
+
+//go:nosplit
+func B32Decode(out *[]byte, src unsafe.Pointer, len int, mod int) (ret int) {
+    return F_b32decode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), len, mod)
+}
+
+//go:nosplit
+func B32Encode(out *[]byte, src *[]byte, mod int) {
+	F_b32encode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), mod)
+}
+
+//go:nosplit
+func HexDecode(out *[]byte, src unsafe.Pointer, len int, mod int) (ret int) {
+    return F_hexdecode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), len, mod)
+}
+
+//go:nosplit
+func HexEncode(out *[]byte, src *[]byte, mod int) {
+	F_hexencode(rt.NoEscape(unsafe.Pointer(out)), rt.NoEscape(unsafe.Pointer(src)), mod)
+}
+
 func init() {
 	if hasAVX2 {
 		useAVX2()
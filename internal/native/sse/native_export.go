@@ -26,4 +26,11 @@ import (
 func Use() {
     loader.WrapGoC(_text_b64encode, _cfunc_b64encode, []loader.GoC{{"_b64encode", &S_b64encode, &F_b64encode}}, "sse", "sse/b64encode.c")
     loader.WrapGoC(_text_b64decode, _cfunc_b64decode, []loader.GoC{{"_b64decode", &S_b64decode, &F_b64decode}}, "sse", "sse/b64decode.c")
+    loader.WrapGoC(_text_b64encbatch, _cfunc_b64encbatch, []loader.GoC{{"_b64encbatch", &S_b64encbatch, &F_b64encbatch}}, "sse", "sse/b64encbatch.c")
+    loader.WrapGoC(_text_b64decbatch, _cfunc_b64decbatch, []loader.GoC{{"_b64decbatch", &S_b64decbatch, &F_b64decbatch}}, "sse", "sse/b64decbatch.c")
+    loader.WrapGoC(_text_b64transcode, _cfunc_b64transcode, []loader.GoC{{"_b64transcode", &S_b64transcode, &F_b64transcode}}, "sse", "sse/b64transcode.c")
+    loader.WrapGoC(_text_b32encode, _cfunc_b32encode, []loader.GoC{{"_b32encode", &S_b32encode, &F_b32encode}}, "sse", "sse/b32encode.c")
+    loader.WrapGoC(_text_b32decode, _cfunc_b32decode, []loader.GoC{{"_b32decode", &S_b32decode, &F_b32decode}}, "sse", "sse/b32decode.c")
+    loader.WrapGoC(_text_hexencode, _cfunc_hexencode, []loader.GoC{{"_hexencode", &S_hexencode, &F_hexencode}}, "sse", "sse/hexencode.c")
+    loader.WrapGoC(_text_hexdecode, _cfunc_hexdecode, []loader.GoC{{"_hexdecode", &S_hexdecode, &F_hexdecode}}, "sse", "sse/hexdecode.c")
 }
\ No newline at end of file
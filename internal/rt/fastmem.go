@@ -17,6 +17,7 @@
 package rt
 
 import (
+	"reflect"
 	"unsafe"
 )
 
@@ -40,3 +41,38 @@ func MoreStack(size uintptr)
 func Add(ptr unsafe.Pointer, off uintptr) unsafe.Pointer {
     return unsafe.Pointer(uintptr(ptr) + off)
 }
+
+//go:nosplit
+func Mem2Str(v []byte) (s string) {
+    (*reflect.StringHeader)(unsafe.Pointer(&s)).Len  = (*reflect.SliceHeader)(unsafe.Pointer(&v)).Len
+    (*reflect.StringHeader)(unsafe.Pointer(&s)).Data = (*reflect.SliceHeader)(unsafe.Pointer(&v)).Data
+    return
+}
+
+//go:nosplit
+func Str2Mem(s string) (v []byte) {
+    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Cap  = (*reflect.StringHeader)(unsafe.Pointer(&s)).Len
+    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Len  = (*reflect.StringHeader)(unsafe.Pointer(&s)).Len
+    (*reflect.SliceHeader)(unsafe.Pointer(&v)).Data = (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+    return
+}
+
+// GrowSlice makes sure buf has room for n more bytes, at least doubling
+// its capacity if it has to grow, since the EncodeUnsafe methods do not
+// check.
+func GrowSlice(buf []byte, n int) []byte {
+    if cap(buf) - len(buf) >= n {
+        return buf
+    }
+
+    /* at least double the capacity */
+    nb := cap(buf) * 2
+    if nb < len(buf) + n {
+        nb = len(buf) + n
+    }
+
+    /* copy into the new buffer */
+    ret := make([]byte, len(buf), nb)
+    copy(ret, buf)
+    return ret
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jws implements the encoding layer of the JWS compact
+// serialization (RFC 7515 section 7.1), which is also used by JWTs.
+// It splits, decodes and encodes the "header.payload.signature"
+// segments with base64x.RawURLEncoding, and does no cryptography.
+package jws
+
+import (
+    `bytes`
+    `encoding/base64`
+    `errors`
+    `strconv`
+
+    `github.com/cloudwego/base64x`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// A Segment identifies one of the three segments of a JWS.
+type Segment int
+
+const (
+    SegmentHeader Segment = iota
+    SegmentPayload
+    SegmentSignature
+)
+
+func (self Segment) String() string {
+    switch self {
+        case SegmentHeader    : return "header"
+        case SegmentPayload   : return "payload"
+        case SegmentSignature : return "signature"
+        default               : return "segment " + strconv.Itoa(int(self))
+    }
+}
+
+// ErrFormat is returned when a token does not consist of exactly three
+// segments separated by '.'.
+var ErrFormat = errors.New("jws: compact serialization must have 3 segments")
+
+// A CorruptSegmentError describes invalid base64url data in a segment.
+// Offset is relative to the beginning of the token.
+type CorruptSegmentError struct {
+    Segment Segment
+    Offset  int64
+}
+
+func (self *CorruptSegmentError) Error() string {
+    return "jws: illegal base64 data in " + self.Segment.String() + " at input byte " + strconv.FormatInt(self.Offset, 10)
+}
+
+var enc = base64x.RawURLEncoding
+
+/** Decoder Functions **/
+
+// Split splits a compact JWS into its three encoded segments, which
+// refer to src. It returns ErrFormat if there are not exactly two '.'.
+func Split(src []byte) (header []byte, payload []byte, signature []byte, err error) {
+    p := bytes.IndexByte(src, '.')
+    if p < 0 {
+        return nil, nil, nil, ErrFormat
+    }
+
+    /* the second separator */
+    q := bytes.IndexByte(src[p + 1:], '.')
+    if q < 0 {
+        return nil, nil, nil, ErrFormat
+    }
+
+    /* there must be no more separators */
+    if q += p + 1; bytes.IndexByte(src[q + 1:], '.') >= 0 {
+        return nil, nil, nil, ErrFormat
+    } else {
+        return src[:p], src[p + 1:q], src[q + 1:], nil
+    }
+}
+
+// A Token is a decoded JWS. The Header, Payload and Signature share
+// one buffer, which is reused by Decode.
+type Token struct {
+    Header       []byte // the decoded JOSE header, usually JSON
+    Payload      []byte // the decoded payload
+    Signature    []byte // the decoded signature
+    SigningInput []byte // the "header.payload" part of the token, not copied
+
+    buf []byte
+}
+
+// Decode decodes the compact JWS in src into the token. The previous
+// contents of the token are overwritten, and SigningInput refers to
+// src.
+//
+// It returns ErrFormat if src does not have three segments, and
+// *CorruptSegmentError if a segment is not valid unpadded base64url.
+func (self *Token) Decode(src []byte) error {
+    h, p, s, err := Split(src)
+    if err != nil {
+        return err
+    }
+
+    /* one buffer for all the segments */
+    nb := enc.DecodedLen(len(h)) + enc.DecodedLen(len(p)) + enc.DecodedLen(len(s))
+    if cap(self.buf) < nb {
+        self.buf = make([]byte, 0, nb)
+    }
+
+    /* decode every segment after the previous one */
+    buf := self.buf[:0]
+    off := 0
+    for i, seg := range [3][]byte{h, p, s} {
+        if err = decodeSegment(&buf, seg, Segment(i), off); err != nil {
+            return err
+        }
+        off += len(seg) + 1
+    }
+
+    /* split the decoded buffer */
+    nh := enc.DecodedLen(len(h))
+    np := enc.DecodedLen(len(p)) + nh
+    self.Header = buf[:nh:nh]
+    self.Payload = buf[nh:np:np]
+    self.Signature = buf[np:]
+    self.SigningInput = src[:len(h) + len(p) + 1]
+    return nil
+}
+
+// decodeSegment appends the decoded seg to out, which is large enough.
+// off is the offset of seg in the token. Line breaks are rejected before
+// decoding, since the decoder would skip them.
+func decodeSegment(out *[]byte, seg []byte, id Segment, off int) error {
+    if i := bytes.IndexAny(seg, "\r\n"); i >= 0 {
+        return &CorruptSegmentError{id, int64(off + i)}
+    }
+
+    /* decode after the previous segments */
+    n, err := enc.DecodeUnsafe(out, seg)
+
+    /* the native decoder reports the end of the segment for an invalid
+     * last character, instead of the character itself */
+    if err != nil {
+        if n = int(err.(base64.CorruptInputError)); n >= len(seg) {
+            n = len(seg) - 1
+        }
+        return &CorruptSegmentError{id, int64(off + n)}
+    }
+    return nil
+}
+
+// Decode returns the decoded compact JWS in src.
+func Decode(src []byte) (*Token, error) {
+    ret := new(Token)
+    if err := ret.Decode(src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// DecodeString returns the decoded compact JWS in s.
+func DecodeString(s string) (*Token, error) {
+    return Decode(rt.Str2Mem(s))
+}
+
+/** Encoder Functions **/
+
+// EncodedLen returns the length of a compact JWS with segments of nh,
+// np and ns bytes.
+func EncodedLen(nh int, np int, ns int) int {
+    return enc.EncodedLen(nh) + enc.EncodedLen(np) + enc.EncodedLen(ns) + 2
+}
+
+// AppendSigningInput appends the "header.payload" part of a compact
+// JWS to dst, which is the input of the signature algorithm.
+func AppendSigningInput(dst []byte, header []byte, payload []byte) []byte {
+    dst = rt.GrowSlice(dst, enc.EncodedLen(len(header)) + enc.EncodedLen(len(payload)) + 1)
+    enc.EncodeUnsafe(&dst, header)
+    dst = append(dst, '.')
+    enc.EncodeUnsafe(&dst, payload)
+    return dst
+}
+
+// AppendSignature appends the ".signature" part of a compact JWS to
+// dst, which usually holds the result of AppendSigningInput.
+func AppendSignature(dst []byte, signature []byte) []byte {
+    dst = rt.GrowSlice(dst, enc.EncodedLen(len(signature)) + 1)
+    dst = append(dst, '.')
+    enc.EncodeUnsafe(&dst, signature)
+    return dst
+}
+
+// Encode returns the compact JWS of the segments.
+func Encode(header []byte, payload []byte, signature []byte) []byte {
+    ret := make([]byte, 0, EncodedLen(len(header), len(payload), len(signature)))
+    ret = AppendSigningInput(ret, header, payload)
+    return AppendSignature(ret, signature)
+}
+
+// EncodeToString returns the compact JWS of the segments.
+func EncodeToString(header []byte, payload []byte, signature []byte) string {
+    return rt.Mem2Str(Encode(header, payload, signature))
+}
+
+// Encode returns the compact JWS of the token, the SigningInput is
+// ignored.
+func (self *Token) Encode() []byte {
+    return Encode(self.Header, self.Payload, self.Signature)
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jws
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+// RFC 7515 appendix A.1
+const (
+    rfcHeader    = "{\"typ\":\"JWT\",\r\n \"alg\":\"HS256\"}"
+    rfcPayload   = "{\"iss\":\"joe\",\r\n \"exp\":1300819380,\r\n \"http://example.com/is_root\":true}"
+    rfcSignature = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+    rfcToken     = "eyJ0eXAiOiJKV1QiLA0KICJhbGciOiJIUzI1NiJ9" +
+                   ".eyJpc3MiOiJqb2UiLA0KICJleHAiOjEzMDA4MTkzODAsDQogImh0dHA6Ly9leGFtcGxlLmNvbS9pc19yb290Ijp0cnVlfQ" +
+                   "." + rfcSignature
+)
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+func TestDecode(t *testing.T) {
+    tok, err := DecodeString(rfcToken)
+    if err != nil {
+        t.Fatal(err)
+    }
+    sig, _ := base64.RawURLEncoding.DecodeString(rfcSignature)
+    testEqual(t, "Header = %q, want %q", string(tok.Header), rfcHeader)
+    testEqual(t, "Payload = %q, want %q", string(tok.Payload), rfcPayload)
+    testEqual(t, "Signature = %x, want %x", string(tok.Signature), string(sig))
+    testEqual(t, "SigningInput = %q, want %q", string(tok.SigningInput), rfcToken[:strings.LastIndexByte(rfcToken, '.')])
+
+    /* appending to a segment must not overwrite the next one */
+    _ = append(tok.Header, "xxxx"...)
+    testEqual(t, "Payload = %q, want %q", string(tok.Payload), rfcPayload)
+
+    /* empty segments, as in unsecured or detached JWS */
+    for _, src := range []string{"..", "e30..", "e30.e30.", ".e30."} {
+        tok, err = DecodeString(src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, error(nil))
+        testEqual(t, "DecodeString(%q) = %q, want %q", src, EncodeToString(tok.Header, tok.Payload, tok.Signature), src)
+    }
+}
+
+func TestDecodeReuse(t *testing.T) {
+    var tok Token
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 100; n++ {
+        h := make([]byte, rng.Intn(64))
+        p := make([]byte, rng.Intn(256))
+        s := make([]byte, rng.Intn(128))
+        rng.Read(h)
+        rng.Read(p)
+        rng.Read(s)
+
+        /* compare with encoding/base64 */
+        src := Encode(h, p, s)
+        want := strings.Join([]string{
+            base64.RawURLEncoding.EncodeToString(h),
+            base64.RawURLEncoding.EncodeToString(p),
+            base64.RawURLEncoding.EncodeToString(s),
+        }, ".")
+        testEqual(t, "Encode() = %q, want %q", string(src), want)
+        testEqual(t, "EncodedLen() = %d, want %d", EncodedLen(len(h), len(p), len(s)), len(want))
+
+        /* decode into the same token */
+        if err := tok.Decode(src); err != nil {
+            t.Fatal(err)
+        }
+        if !bytes.Equal(tok.Header, h) || !bytes.Equal(tok.Payload, p) || !bytes.Equal(tok.Signature, s) {
+            t.Errorf("Decode(%q) = %x, %x, %x", src, tok.Header, tok.Payload, tok.Signature)
+        }
+        testEqual(t, "Encode() = %q, want %q", string(tok.Encode()), want)
+    }
+}
+
+func TestSign(t *testing.T) {
+    buf := AppendSigningInput(nil, []byte(rfcHeader), []byte(rfcPayload))
+    testEqual(t, "AppendSigningInput() = %q, want %q", string(buf), rfcToken[:strings.LastIndexByte(rfcToken, '.')])
+    sig, _ := base64.RawURLEncoding.DecodeString(rfcSignature)
+    buf = AppendSignature(buf, sig)
+    testEqual(t, "AppendSignature() = %q, want %q", string(buf), rfcToken)
+}
+
+func TestDecodeError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        err error
+    }{
+        {"", ErrFormat},
+        {"e30", ErrFormat},
+        {"e30.e30", ErrFormat},
+        {"e30.e30.e30.e30", ErrFormat},
+        {"e3!.e30.", &CorruptSegmentError{SegmentHeader, 2}},
+        {"e30.e30=.", &CorruptSegmentError{SegmentPayload, 7}},
+        {"e30.e30.e", &CorruptSegmentError{SegmentSignature, 8}},
+        {"e30.e3+0.", &CorruptSegmentError{SegmentPayload, 6}},
+        {"e30.e\n30.", &CorruptSegmentError{SegmentPayload, 5}},
+        {"e30.e30.e30\r\n", &CorruptSegmentError{SegmentSignature, 11}},
+        {"AAAA.AA\nAA.AAAA", &CorruptSegmentError{SegmentPayload, 7}},
+        {"AAAA\n.AAAA.AAAA", &CorruptSegmentError{SegmentHeader, 4}},
+    } {
+        _, err := DecodeString(tt.src)
+        if e, ok := err.(*CorruptSegmentError); ok {
+            testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, *e, *tt.err.(*CorruptSegmentError))
+        } else {
+            testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, tt.err)
+        }
+    }
+    err := &CorruptSegmentError{SegmentPayload, 7}
+    testEqual(t, "Error() = %q, want %q", err.Error(), "jws: illegal base64 data in payload at input byte 7")
+}
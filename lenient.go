@@ -0,0 +1,68 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+)
+
+// Lenient returns an encoding like self, except it decodes both the
+// standard and the URL-safe alphabet, that is, both '+' and '-' as 62,
+// and both '/' and '_' as 63, even mixed within one input. The encoders
+// are unaffected.
+//
+// Both alphabets are decoded in a single pass by the native decoder,
+// which merges their tables, so there is no extra cost over the strict
+// encodings, and DecodeInPlace works the same.
+func (self Encoding) Lenient() Encoding {
+    return self | _MODE_ANY
+}
+
+// LenientUnmixed returns an encoding like Lenient, except inputs that
+// mix the two alphabets are rejected with base64.CorruptInputError, at
+// the first character of the alphabet that appears last. With JSON
+// escapes, the alphabet is detected from the unescaped characters only.
+func (self Encoding) LenientUnmixed() Encoding {
+    return self | _MODE_ANY | _MODE_UNMIXED
+}
+
+// IsLenient reports whether self decodes both alphabets.
+func (self Encoding) IsLenient() bool {
+    return (self & _MODE_ANY) != 0
+}
+
+// decodeTable returns the table of the characters self decodes in src.
+// The unmixed lenient mode selects the alphabet as the native decoder
+// does, by the first character of src that differs between them.
+func (self Encoding) decodeTable(src []byte) *[256]byte {
+    if (self & _MODE_ANY) == 0 {
+        if (self & _MODE_URL) != 0 {
+            return &decodeTableURL
+        } else {
+            return &decodeTableStd
+        }
+    }
+
+    /* the lenient modes */
+    if (self & _MODE_UNMIXED) == 0 {
+        return &decodeTableAny
+    } else if i := bytes.IndexAny(src, "+/-_"); i >= 0 && (src[i] == '-' || src[i] == '_') {
+        return &decodeTableURL
+    } else {
+        return &decodeTableStd
+    }
+}
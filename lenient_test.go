@@ -0,0 +1,153 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+func TestLenient(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 300; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* both alphabets, with and without paddings */
+        for _, tt := range encodingTests {
+            enc := tt.enc.Lenient()
+            for _, in := range []Encoding{StdEncoding, URLEncoding} {
+                encoded := (in | tt.enc & _MODE_RAW).EncodeToString(src)
+                dec, err := enc.DecodeString(encoded)
+                if err != nil || !bytes.Equal(dec, src) {
+                    t.Fatalf("DecodeString(%q) = %x, %v, want %x", encoded, dec, err, src)
+                }
+                dec, err = tt.enc.LenientUnmixed().DecodeString(encoded)
+                if err != nil || !bytes.Equal(dec, src) {
+                    t.Fatalf("DecodeString(%q) = %x, %v, want %x", encoded, dec, err, src)
+                }
+            }
+
+            /* the encoders are unaffected */
+            testEqual(t, "EncodeToString(%x) = %q, want %q", src, enc.EncodeToString(src), tt.enc.EncodeToString(src))
+        }
+    }
+}
+
+func TestLenientMixed(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        out string
+        pos int
+    }{
+        {"-_-_Pw==", "\xfb\xff\xbf?", -1},
+        {"+/+/Pw==", "\xfb\xff\xbf?", -1},
+        {"+_-/Pw==", "\xfb\xff\xbf?", 1},
+        {"-/+_Pw==", "\xfb\xff\xbf?", 1},
+        {"AAAA+AAA\r\nAA_A", "\x00\x00\x00\xf8\x00\x00\x00\x0f\xc0", 12},
+    } {
+        dec, err := StdEncoding.Lenient().DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(nil))
+        testEqual(t, "DecodeString(%q) = %q, want %q", tt.src, string(dec), tt.out)
+
+        /* mixing may be rejected */
+        var want error
+        if tt.pos >= 0 {
+            want = base64.CorruptInputError(tt.pos)
+        }
+        _, err = StdEncoding.LenientUnmixed().DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, want)
+    }
+
+    /* the earliest error wins */
+    _, err := StdEncoding.LenientUnmixed().DecodeString("AA!A+AAAAAA_")
+    testEqual(t, "DecodeString() = error %v, want %v", err, error(base64.CorruptInputError(2)))
+    _, err = StdEncoding.Lenient().DecodeString("AA!A+AAAAAA_")
+    testEqual(t, "DecodeString() = error %v, want %v", err, error(base64.CorruptInputError(2)))
+
+    /* JSON escapes */
+    dec, err := JSONStdEncoding.Lenient().DecodeString(`-_-\/Pw==`)
+    testEqual(t, "DecodeString() = error %v, want %v", err, error(nil))
+    testEqual(t, "DecodeString() = %q, want %q", string(dec), "\xfb\xff\xbf?")
+}
+
+func TestLenientRandom(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZaz09+/-_=\r\n!"
+    for _, tt := range encodingTests {
+        for n := 0; n < 200; n++ {
+            for i := 0; i < 20; i++ {
+                buf := make([]byte, n)
+                rng.Read(buf)
+                src := []byte(tt.enc.EncodeToString(buf))
+                for j := rng.Intn(4); j > 0 && len(src) != 0; j-- {
+                    src[rng.Intn(len(src))] = alphabet[rng.Intn(len(alphabet))]
+                }
+
+                /* mixed inputs decode as if mapped to the standard alphabet */
+                std := strings.NewReplacer("-", "+", "_", "/").Replace(string(src))
+                want, werr := (tt.enc &^ _MODE_URL).DecodeString(std)
+                got, err := tt.enc.Lenient().DecodeString(string(src))
+                testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, werr)
+                testEqual(t, "DecodeString(%q) = %q, want %q", src, string(got), string(want))
+
+                /* the first character that differs selects the alphabet */
+                enc := tt.enc &^ _MODE_URL
+                if k := bytes.IndexAny(src, "+/-_"); k >= 0 && (src[k] == '-' || src[k] == '_') {
+                    enc |= _MODE_URL
+                }
+                want, werr = enc.DecodeString(string(src))
+                got, err = tt.enc.LenientUnmixed().DecodeString(string(src))
+                testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, werr)
+                testEqual(t, "DecodeString(%q) = %q, want %q", src, string(got), string(want))
+            }
+        }
+    }
+}
+
+func TestLenientFlags(t *testing.T) {
+    testEqual(t, "IsLenient() = %v, want %v", StdEncoding.IsLenient(), false)
+    testEqual(t, "IsLenient() = %v, want %v", RawURLEncoding.Lenient().IsLenient(), true)
+    testEqual(t, "IsLenient() = %v, want %v", URLEncoding.LenientUnmixed().IsLenient(), true)
+}
+
+func TestLenientParallel(t *testing.T) {
+    withProcs(8, func() {
+        src := make([]byte, _PARALLEL_MIN * 3)
+        rand.New(rand.NewSource(0)).Read(src)
+
+        /* the halves use different alphabets */
+        enc := []byte(StdEncoding.EncodeToString(src))
+        copy(enc[len(enc) / 2:], URLEncoding.EncodeToString(src[len(enc) / 8 * 3:]))
+        out := make([]byte, StdEncoding.DecodedLen(len(enc)))
+        nb, err := StdEncoding.Lenient().DecodeParallel(out, enc)
+        if err != nil || !bytes.Equal(out[:nb], src) {
+            t.Errorf("DecodeParallel() = %v", err)
+        }
+
+        /* mixing is detected across the parts */
+        _, err = StdEncoding.LenientUnmixed().DecodeParallel(out, enc)
+        _, want := StdEncoding.LenientUnmixed().Decode(out, enc)
+        testEqual(t, "DecodeParallel() = error %v, want %v", err, want)
+        if err == nil {
+            t.Errorf("DecodeParallel() accepted mixed alphabets")
+        }
+    })
+}
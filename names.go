@@ -0,0 +1,161 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `strconv`
+    `strings`
+)
+
+const (
+    _NAME_JSON    = "json-"
+    _NAME_RAW     = "raw-"
+    _NAME_STD     = "std"
+    _NAME_URL     = "url"
+    _NAME_LENIENT = "lenient"
+    _NAME_UNMIXED = "lenient-unmixed"
+    _NAME_OPTPAD  = "optional-padding"
+    _NAME_SEP     = "+"
+)
+
+// _MODE_ALL are the modes an Encoding may be made of.
+const _MODE_ALL = _MODE_URL | _MODE_RAW | _MODE_JSON | _MODE_ANY | _MODE_UNMIXED | _MODE_GO
+
+// UnknownEncodingError is the error returned by ParseEncoding for names
+// that do not describe an encoding.
+type UnknownEncodingError string
+
+func (e UnknownEncodingError) Error() string {
+    return "base64x: unknown encoding " + strconv.Quote(string(e))
+}
+
+// ParseEncoding returns the encoding with the given name, as returned by
+// String. The names are of the form "[json-][raw-]std" or
+// "[json-][raw-]url", such as "std", "raw-url" or "json-std", followed
+// by the modifiers "+lenient" or "+lenient-unmixed", and
+// "+optional-padding", in any order. Names are case-insensitive.
+func ParseEncoding(name string) (Encoding, error) {
+    var ret Encoding
+    ss := strings.Split(strings.ToLower(name), _NAME_SEP)
+    enc := ss[0]
+
+    /* the base encoding */
+    if strings.HasPrefix(enc, _NAME_JSON) {
+        enc = enc[len(_NAME_JSON):]
+        ret |= _MODE_JSON
+    }
+    if strings.HasPrefix(enc, _NAME_RAW) {
+        enc = enc[len(_NAME_RAW):]
+        ret |= _MODE_RAW
+    }
+    switch enc {
+        case _NAME_STD : break
+        case _NAME_URL : ret |= _MODE_URL
+        default        : return 0, UnknownEncodingError(name)
+    }
+
+    /* the modifiers, each of them at most once */
+    for _, mod := range ss[1:] {
+        var mode Encoding
+        switch mod {
+            case _NAME_LENIENT : mode = _MODE_ANY
+            case _NAME_UNMIXED : mode = _MODE_ANY | _MODE_UNMIXED
+            case _NAME_OPTPAD  : mode = _MODE_OPTPAD
+            default            : return 0, UnknownEncodingError(name)
+        }
+        if (ret & mode) != 0 {
+            return 0, UnknownEncodingError(name)
+        }
+        ret |= mode
+    }
+    return ret, nil
+}
+
+// String returns the name of the encoding, as accepted by
+// ParseEncoding, such as "std", "raw-url" or "json-std+lenient". Values
+// that are not made by this package are printed as "Encoding(N)".
+func (self Encoding) String() string {
+    if !self.valid() {
+        return "Encoding(" + strconv.Itoa(int(self)) + ")"
+    }
+
+    /* the base encoding */
+    var sb strings.Builder
+    if self.IsJSON() {
+        sb.WriteString(_NAME_JSON)
+    }
+    if !self.HasPadding() {
+        sb.WriteString(_NAME_RAW)
+    }
+    if self.IsURL() {
+        sb.WriteString(_NAME_URL)
+    } else {
+        sb.WriteString(_NAME_STD)
+    }
+
+    /* the modifiers */
+    switch self & (_MODE_ANY | _MODE_UNMIXED) {
+        case _MODE_ANY                 : sb.WriteString(_NAME_SEP + _NAME_LENIENT)
+        case _MODE_ANY | _MODE_UNMIXED : sb.WriteString(_NAME_SEP + _NAME_UNMIXED)
+    }
+    if self.IsPaddingOptional() {
+        sb.WriteString(_NAME_SEP + _NAME_OPTPAD)
+    }
+    return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, with the name returned
+// by String.
+func (self Encoding) MarshalText() ([]byte, error) {
+    if !self.valid() {
+        return nil, UnknownEncodingError(self.String())
+    } else {
+        return []byte(self.String()), nil
+    }
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, with the names
+// accepted by ParseEncoding.
+func (self *Encoding) UnmarshalText(text []byte) error {
+    if enc, err := ParseEncoding(string(text)); err != nil {
+        return err
+    } else {
+        *self = enc
+        return nil
+    }
+}
+
+// IsURL reports whether self uses the URL-safe alphabet.
+func (self Encoding) IsURL() bool {
+    return (self & _MODE_URL) != 0
+}
+
+// HasPadding reports whether self pads the encoded data with '='.
+func (self Encoding) HasPadding() bool {
+    return (self & _MODE_RAW) == 0
+}
+
+// IsJSON reports whether self decodes JSON escapes.
+func (self Encoding) IsJSON() bool {
+    return (self & _MODE_JSON) != 0
+}
+
+// valid reports whether self is made of the known modes only.
+func (self Encoding) valid() bool {
+    return (self &^ _MODE_ALL) == 0 && (self & (_MODE_ANY | _MODE_UNMIXED)) != _MODE_UNMIXED
+}
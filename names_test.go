@@ -0,0 +1,115 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `encoding/json`
+    `testing`
+)
+
+func TestEncodingNames(t *testing.T) {
+    for _, tt := range []struct {
+        enc  Encoding
+        name string
+    }{
+        {StdEncoding, "std"},
+        {URLEncoding, "url"},
+        {RawStdEncoding, "raw-std"},
+        {RawURLEncoding, "raw-url"},
+        {JSONStdEncoding, "json-std"},
+        {JSONStdEncoding | _MODE_RAW | _MODE_URL, "json-raw-url"},
+        {StdEncoding.Lenient(), "std+lenient"},
+        {URLEncoding.LenientUnmixed(), "url+lenient-unmixed"},
+        {RawStdEncoding.OptionalPadding(), "raw-std+optional-padding"},
+        {JSONStdEncoding.OptionalPadding().Lenient(), "json-std+lenient+optional-padding"},
+    } {
+        testEqual(t, "String() = %q, want %q", tt.enc.String(), tt.name)
+        enc, err := ParseEncoding(tt.name)
+        testEqual(t, "ParseEncoding(%q) = error %v, want %v", tt.name, err, error(nil))
+        testEqual(t, "ParseEncoding(%q) = %v, want %v", tt.name, int(enc), int(tt.enc))
+    }
+
+    /* the modifiers in any order, and any case */
+    enc, err := ParseEncoding("JSON-Std+Optional-Padding+Lenient")
+    testEqual(t, "ParseEncoding() = error %v, want %v", err, error(nil))
+    testEqual(t, "ParseEncoding() = %v, want %v", enc, JSONStdEncoding.Lenient().OptionalPadding())
+
+    /* invalid values */
+    testEqual(t, "String() = %q, want %q", Encoding(_MODE_UNMIXED).String(), "Encoding(32)")
+    testEqual(t, "String() = %q, want %q", Encoding(_MODE_AVX2).String(), "Encoding(4)")
+    _, err = Encoding(1 << 10).MarshalText()
+    testEqual(t, "MarshalText() = error %v, want %v", err, error(UnknownEncodingError("Encoding(1024)")))
+}
+
+func TestParseEncodingErrors(t *testing.T) {
+    for _, name := range []string{
+        "",
+        "raw",
+        "json",
+        "raw-json-std",
+        "std+",
+        "std+raw",
+        "std+lenient+lenient",
+        "std+lenient+lenient-unmixed",
+        " std",
+    } {
+        _, err := ParseEncoding(name)
+        testEqual(t, "ParseEncoding(%q) = error %v, want %v", name, err, error(UnknownEncodingError(name)))
+    }
+}
+
+func TestEncodingAccessors(t *testing.T) {
+    for _, tt := range []struct {
+        enc  Encoding
+        url  bool
+        pad  bool
+        json bool
+    }{
+        {StdEncoding, false, true, false},
+        {URLEncoding, true, true, false},
+        {RawStdEncoding, false, false, false},
+        {RawURLEncoding, true, false, false},
+        {JSONStdEncoding, false, true, true},
+        {RawURLEncoding.Lenient().OptionalPadding(), true, false, false},
+    } {
+        testEqual(t, "%v.IsURL() = %v, want %v", tt.enc, tt.enc.IsURL(), tt.url)
+        testEqual(t, "%v.HasPadding() = %v, want %v", tt.enc, tt.enc.HasPadding(), tt.pad)
+        testEqual(t, "%v.IsJSON() = %v, want %v", tt.enc, tt.enc.IsJSON(), tt.json)
+    }
+}
+
+func TestEncodingText(t *testing.T) {
+    type config struct {
+        Encoding Encoding `json:"encoding"`
+    }
+
+    /* round trip through the text marshalers */
+    in := config{RawURLEncoding.OptionalPadding()}
+    buf, err := json.Marshal(in)
+    testEqual(t, "Marshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "Marshal() = %s, want %s", string(buf), `{"encoding":"raw-url+optional-padding"}`)
+
+    var out config
+    err = json.Unmarshal(buf, &out)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "Unmarshal() = %v, want %v", out, in)
+
+    /* unknown names are rejected */
+    err = json.Unmarshal([]byte(`{"encoding":"base32"}`), &out)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(UnknownEncodingError("base32")))
+}
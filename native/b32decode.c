@@ -0,0 +1,5 @@
+#include "base32.h"
+
+ssize_t b32decode(struct slice_t *out, const char *src, size_t nb, int mode) {
+    return do_b32decode(out, src, nb, mode);
+}
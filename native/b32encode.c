@@ -0,0 +1,5 @@
+#include "base32.h"
+
+void b32encode(struct slice_t *out, const struct slice_t *src, int mode) {
+     do_b32encode(out, src, mode);
+}
@@ -0,0 +1,5 @@
+#include "native.h"
+
+ssize_t b64decbatch(struct slice_t *out, const struct slice_t *srcs, size_t nb, int64_t *offs, int mode) {
+    return do_b64decbatch(out, srcs, nb, offs, mode);
+}
@@ -0,0 +1,5 @@
+#include "native.h"
+
+void b64encbatch(struct slice_t *out, const struct slice_t *srcs, size_t nb, int64_t *offs, int mode) {
+     do_b64encbatch(out, srcs, nb, offs, mode);
+}
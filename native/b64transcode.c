@@ -0,0 +1,5 @@
+#include "native.h"
+
+ssize_t b64transcode(struct slice_t *out, const char *src, size_t nb, int from, int to) {
+    return do_b64transcode(out, src, nb, from, to);
+}
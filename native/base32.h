@@ -0,0 +1,397 @@
+#include "native.h"
+
+#define MODE_HEX        1
+
+/** Encoder Helper Functions **/
+
+static const char TabEncodeCharsetB32Std[32] = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567";
+static const char TabEncodeCharsetB32Hex[32] = "0123456789ABCDEFGHIJKLMNOPQRSTUV";
+
+/* the 16-bit words holding every 5 bits of a 5-byte group, in big-endian
+ * order, the first one is at offset 0 (or 6) and the second one at offset
+ * 5 (or 11) of the lanes, the last word only needs the high byte */
+static const uint8_t VecEncodeB32Shuffles[64] = {
+     1,  0,  1,  0,  2,  1,  2,  1,  3,  2,  4,  3,  4,  3, 128,  4,
+     7,  6,  7,  6,  8,  7,  8,  7,  9,  8, 10,  9, 10,  9, 128, 10,
+     6,  5,  6,  5,  7,  6,  7,  6,  8,  7,  9,  8,  9,  8, 128,  9,
+    12, 11, 12, 11, 13, 12, 13, 12, 14, 13, 15, 14, 15, 14, 128, 15,
+};
+
+/* shifts every 5 bits to the bottom of the words with a multiplication,
+ * the shifts are 11, 6, 9, 4, 7, 10, 5 and 8 bits */
+static const uint16_t VecEncodeB32Shifts[16] = {
+    1 << 5, 1 << 10, 1 << 7, 1 << 12, 1 << 9, 1 << 6, 1 << 11, 1 << 8,
+    1 << 5, 1 << 10, 1 << 7, 1 << 12, 1 << 9, 1 << 6, 1 << 11, 1 << 8,
+};
+
+static always_inline __m256i encode_b32_avx2(__m256i vv, const uint8_t *sh, int mode) {
+    __m256i ms = _mm256_loadu_si256  (as_m256c(VecEncodeB32Shifts));
+    __m256i s0 = _mm256_loadu_si256  (as_m256c(sh));
+    __m256i s1 = _mm256_loadu_si256  (as_m256c(sh + 32));
+    __m256i w0 = _mm256_shuffle_epi8 (vv, s0);
+    __m256i w1 = _mm256_shuffle_epi8 (vv, s1);
+    __m256i r0 = _mm256_mulhi_epu16  (w0, ms);
+    __m256i r1 = _mm256_mulhi_epu16  (w1, ms);
+    __m256i m0 = _mm256_and_si256    (r0, _mm256_set1_epi16(0x1f));
+    __m256i m1 = _mm256_and_si256    (r1, _mm256_set1_epi16(0x1f));
+    __m256i vi = _mm256_packus_epi16 (m0, m1);
+
+    /* map to the alphabet */
+    if (mode & MODE_HEX) {
+        __m256i gt = _mm256_cmpgt_epi8 (vi, _mm256_set1_epi8(9));
+        __m256i dv = _mm256_and_si256  (gt, _mm256_set1_epi8('A' - 10 - '0'));
+        return _mm256_add_epi8(_mm256_add_epi8(vi, _mm256_set1_epi8('0')), dv);
+    } else {
+        __m256i gt = _mm256_cmpgt_epi8 (vi, _mm256_set1_epi8(25));
+        __m256i dv = _mm256_and_si256  (gt, _mm256_set1_epi8('2' - 26 - 'A'));
+        return _mm256_add_epi8(_mm256_add_epi8(vi, _mm256_set1_epi8('A')), dv);
+    }
+}
+
+/** Function Implementations **/
+
+static always_inline void do_b32encode(struct slice_t *out, const struct slice_t *src, int mode) {
+    char *       ob = out->buf + out->len;
+    char *       op = out->buf + out->len;
+    const char * ip = src->buf;
+    const char * ie = src->buf + src->len;
+    const char * st = TabEncodeCharsetB32Std;
+
+    /* check for empty string */
+    if (src->len == 0) {
+        return;
+    }
+
+    /* check for the hex alphabet */
+    if (mode & MODE_HEX) {
+        st = TabEncodeCharsetB32Hex;
+    }
+
+    /* SIMD 20 bytes loop, the high lane is loaded 4 bytes after the low
+     * lane, so the loads end exactly at the 20th byte */
+#ifdef __AVX2__
+    while (ip <= ie - 20) {
+        __m128i v0 = _mm_loadu_si128  (as_m128c(ip));
+        __m128i v1 = _mm_loadu_si128  (as_m128c(ip + 4));
+        __m256i vv = _mm256_set_m128i (v1, v0);
+
+        /* encode and store the result, and advance buffer pointers */
+        _mm256_storeu_si256(as_m256p(op), encode_b32_avx2(vv, VecEncodeB32Shuffles, mode));
+        op += 32;
+        ip += 20;
+    }
+#endif
+
+    /* handle the remaining groups with scalar code (with 8 bytes load) */
+    while (ip <= ie - 8) {
+        uint64_t v0 = __builtin_bswap64(*(const uint64_t *)ip) >> 24;
+
+        /* encode the characters, and move to next group */
+        op[0] = st[(v0 >> 35) & 0x1f];
+        op[1] = st[(v0 >> 30) & 0x1f];
+        op[2] = st[(v0 >> 25) & 0x1f];
+        op[3] = st[(v0 >> 20) & 0x1f];
+        op[4] = st[(v0 >> 15) & 0x1f];
+        op[5] = st[(v0 >> 10) & 0x1f];
+        op[6] = st[(v0 >>  5) & 0x1f];
+        op[7] = st[(v0 >>  0) & 0x1f];
+        op += 8;
+        ip += 5;
+    }
+
+    /* the last groups, byte by byte */
+    while (ip < ie) {
+        int      nb = ie - ip < 5 ? ie - ip : 5;
+        int      nc = (nb * 8 + 4) / 5;
+        uint64_t v0 = 0;
+
+        /* load the bytes, the missing ones are zeros */
+        for (int i = 0; i < 5; i++) {
+            v0 = (v0 << 8) | (i < nb ? (uint8_t)ip[i] : 0);
+        }
+
+        /* encode the characters */
+        for (int i = 0; i < nc; i++) {
+            *op++ = st[(v0 >> (35 - i * 5)) & 0x1f];
+        }
+
+        /* pad if needed */
+        if ((mode & MODE_RAW) == 0) {
+            for (int i = nc; i < 8; i++) {
+                *op++ = '=';
+            }
+        }
+
+        /* move to next group */
+        ip += nb;
+    }
+
+    /* update the result length */
+    out->len += op - ob;
+}
+
+/** Decoder Helper Functions **/
+
+static const uint8_t VecDecodeCharsetB32Std[256] = {
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff,   26,   27,   28,   29,   30,   31, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff,    0,    1,    2,    3,    4,    5,    6,    7,    8,    9,   10,   11,   12,   13,   14,
+      15,   16,   17,   18,   19,   20,   21,   22,   23,   24,   25, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff
+};
+
+static const uint8_t VecDecodeCharsetB32Hex[256] = {
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+       0,    1,    2,    3,    4,    5,    6,    7,    8,    9, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff,   10,   11,   12,   13,   14,   15,   16,   17,   18,   19,   20,   21,   22,   23,   24,
+      25,   26,   27,   28,   29,   30,   31, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff
+};
+
+/* the 5 bytes of every 40-bit group in big-endian order */
+static const uint8_t VecDecodeB32Packing[32] = {
+    4, 3, 2, 1, 0, 12, 11, 10, 9, 8, 128, 128, 128, 128, 128, 128,
+    4, 3, 2, 1, 0, 12, 11, 10, 9, 8, 128, 128, 128, 128, 128, 128,
+};
+
+/* Returns 32 if all the characters are valid, otherwise the position of
+ * the first invalid one (or a new line, or a padding) */
+static always_inline __m256i decode_b32_avx2(__m256i v0, int *pos, int mode) {
+    __m256i m0, m1, vv;
+
+    /* map the characters */
+    if (mode & MODE_HEX) {
+        m0 = _mm256_and_si256(_mm256_cmpgt_epi8(v0, _mm256_set1_epi8('0' - 1)), _mm256_cmpgt_epi8(_mm256_set1_epi8('9' + 1), v0));
+        m1 = _mm256_and_si256(_mm256_cmpgt_epi8(v0, _mm256_set1_epi8('A' - 1)), _mm256_cmpgt_epi8(_mm256_set1_epi8('V' + 1), v0));
+        vv = _mm256_sub_epi8(v0, _mm256_and_si256(m0, _mm256_set1_epi8('0')));
+        vv = _mm256_sub_epi8(vv, _mm256_and_si256(m1, _mm256_set1_epi8('A' - 10)));
+    } else {
+        m0 = _mm256_and_si256(_mm256_cmpgt_epi8(v0, _mm256_set1_epi8('A' - 1)), _mm256_cmpgt_epi8(_mm256_set1_epi8('Z' + 1), v0));
+        m1 = _mm256_and_si256(_mm256_cmpgt_epi8(v0, _mm256_set1_epi8('2' - 1)), _mm256_cmpgt_epi8(_mm256_set1_epi8('7' + 1), v0));
+        vv = _mm256_sub_epi8(v0, _mm256_and_si256(m0, _mm256_set1_epi8('A')));
+        vv = _mm256_sub_epi8(vv, _mm256_and_si256(m1, _mm256_set1_epi8('2' - 26)));
+    }
+
+    /* combine every 8 characters into 40 bits */
+    __m256i r0 = _mm256_maddubs_epi16 (vv, _mm256_set1_epi16(0x0120));
+    __m256i r1 = _mm256_madd_epi16    (r0, _mm256_set1_epi32(0x00010400));
+    __m256i r2 = _mm256_and_si256     (r1, _mm256_set1_epi64x(0xfffff));
+    __m256i r3 = _mm256_slli_epi64    (r2, 20);
+    __m256i r4 = _mm256_srli_epi64    (r1, 32);
+    __m256i r5 = _mm256_or_si256      (r3, r4);
+    __m256i r6 = _mm256_shuffle_epi8  (r5, _mm256_loadu_si256(as_m256c(VecDecodeB32Packing)));
+    int64_t mp = _mm256_movemask_epi8 (_mm256_or_si256(m0, m1));
+    int32_t np = __builtin_ctzll      (~mp | 0xffffffff00000000);
+    return (*pos = np), r6;
+}
+
+static always_inline int b32_valid_tail(int nb) {
+    return nb == 2 || nb == 4 || nb == 5 || nb == 7;
+}
+
+/* Decodes up to 8 characters, skipping new lines. Returns 0 if success,
+ * otherwise returns the error position + 1 */
+static always_inline int64_t decode_b32_block(
+    const uint8_t *  ib,
+    const uint8_t *  ie,
+    const uint8_t ** ipp,
+    char **          opp,
+    const uint8_t *  tab,
+    int              mode
+) {
+    int             nb = 0;
+    int             np = 0;
+    uint64_t        v0 = 0;
+    uint8_t         ch = 0;
+    uint8_t         id = 0;
+    const uint8_t * ip = *ipp;
+
+    /* load up to 8 characters */
+    while (ip < ie && nb < 8 && np == 0) {
+        ch = *ip++;
+
+        /* skip new lines */
+        if (ch == '\r' || ch == '\n') {
+            continue;
+        }
+
+        /* lookup the index, and check for invalid characters */
+        if ((id = tab[ch]) != 0xff) {
+            v0 = (v0 << 5) | id;
+            nb++;
+            continue;
+        }
+
+        /* must be the padding */
+        if ((mode & MODE_RAW) || ch != '=' || !b32_valid_tail(nb)) {
+            return ip - ib;
+        }
+
+        /* the remaining characters must be exactly 8 - nb paddings */
+        for (np = 1; ip < ie; ip++) {
+            if ((ch = *ip) == '\r' || ch == '\n') {
+                continue;
+            } else if (ch != '=' || np == 8 - nb) {
+                return ip - ib + 1;
+            } else {
+                np++;
+            }
+        }
+
+        /* not enough paddings */
+        if (np != 8 - nb) {
+            return ip - ib + 1;
+        }
+    }
+
+    /* only new lines */
+    if (nb == 0) {
+        *ipp = ip;
+        return 0;
+    }
+
+    /* eof without paddings, MODE_STD needs paddings */
+    if (nb != 8 && np == 0) {
+        if (!(mode & MODE_RAW) || !b32_valid_tail(nb)) {
+            return ip - ib + 1;
+        }
+    }
+
+    /* decode into output */
+    char * op = *opp;
+    int    nc = nb * 5 / 8;
+
+    /* store the bytes */
+    v0 <<= 5 * (8 - nb);
+    for (int i = 0; i < nc; i++) {
+        op[i] = (v0 >> (32 - i * 8)) & 0xff;
+    }
+
+    /* update the pointers */
+    *ipp = ip;
+    *opp = op + nc;
+    return 0;
+}
+
+static always_inline ssize_t do_b32decode(struct slice_t *out, const char *src, size_t nb, int mode) {
+    int64_t dv;
+
+    /* check for empty input */
+    if (nb == 0) {
+        return 0;
+    }
+
+    /* output buffer */
+    char *ob = out->buf + out->len;
+    char *op = out->buf + out->len;
+    char *oe = out->buf + out->cap;
+
+    /* input buffer */
+    const uint8_t *st = VecDecodeCharsetB32Std;
+    const uint8_t *ib = (const uint8_t *)src;
+    const uint8_t *ip = (const uint8_t *)src;
+    const uint8_t *ie = (const uint8_t *)src + nb;
+
+    /* check for the hex alphabet */
+    if (mode & MODE_HEX) {
+        st = VecDecodeCharsetB32Hex;
+    }
+
+#ifdef USE_AVX2
+    /* decode every 32 characters, each lane is stored with 16 bytes, so
+     * it stores 6 bytes past the 20 decoded ones */
+    while ((ip <= ie - 32) && (op <= oe - 26)) {
+        int     ep;
+        __m256i vv = _mm256_loadu_si256(as_m256c(ip));
+        __m256i rv = decode_b32_avx2(vv, &ep, mode);
+
+        /* check for invalid characters (or new lines, or paddings) */
+        if (ep < 32) {
+            if ((dv = decode_b32_block(ib, ie, &ip, &op, st, mode)) != 0) {
+                return -dv;
+            } else {
+                continue;
+            }
+        }
+
+        /* store the result */
+        _mm_storeu_si128(as_m128p(op), _mm256_castsi256_si128(rv));
+        _mm_storeu_si128(as_m128p(op + 10), _mm256_extracti128_si256(rv, 1));
+
+        /* move to next block */
+        ip += 32;
+        op += 20;
+    }
+#endif
+
+    /* handle the remaining characters with scalar code (8 byte loop) */
+    while (ip <= ie - 8 && op <= oe - 5) {
+        uint8_t v0 = st[ip[0]];
+        uint8_t v1 = st[ip[1]];
+        uint8_t v2 = st[ip[2]];
+        uint8_t v3 = st[ip[3]];
+        uint8_t v4 = st[ip[4]];
+        uint8_t v5 = st[ip[5]];
+        uint8_t v6 = st[ip[6]];
+        uint8_t v7 = st[ip[7]];
+
+        /* check for invalid bytes */
+        if ((v0 | v1 | v2 | v3 | v4 | v5 | v6 | v7) == 0xff) {
+            if ((dv = decode_b32_block(ib, ie, &ip, &op, st, mode)) != 0) {
+                return -dv;
+            } else {
+                continue;
+            }
+        }
+
+        /* construct the bytes */
+        uint64_t vv =
+            ((uint64_t)v0 << 35) |
+            ((uint64_t)v1 << 30) |
+            ((uint64_t)v2 << 25) |
+            ((uint64_t)v3 << 20) |
+            ((uint64_t)v4 << 15) |
+            ((uint64_t)v5 << 10) |
+            ((uint64_t)v6 <<  5) |
+            ((uint64_t)v7 <<  0);
+
+        /* store the result, and move to next block */
+        as_m32v(op) = __builtin_bswap32(vv >> 8);
+        op[4] = vv & 0xff;
+        ip += 8;
+        op += 5;
+    }
+
+    /* decode the last few characters */
+    while (ip < ie) {
+        if ((dv = decode_b32_block(ib, ie, &ip, &op, st, mode)) != 0) {
+            return -dv;
+        }
+    }
+
+    /* update the result length */
+    out->len += op - ob;
+    return op - ob;
+}
@@ -0,0 +1,206 @@
+#include "native.h"
+
+#define MODE_UPPER      1
+
+/** Encoder Helper Functions **/
+
+static const char TabEncodeCharsetHexLower[16] = "0123456789abcdef";
+static const char TabEncodeCharsetHexUpper[16] = "0123456789ABCDEF";
+
+static const uint8_t VecEncodeCharsetHexLower[32] = {
+    '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'a', 'b', 'c', 'd', 'e', 'f',
+    '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'a', 'b', 'c', 'd', 'e', 'f',
+};
+
+static const uint8_t VecEncodeCharsetHexUpper[32] = {
+    '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'A', 'B', 'C', 'D', 'E', 'F',
+    '0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'A', 'B', 'C', 'D', 'E', 'F',
+};
+
+/* Returns the characters of the 16 bytes in the low lane of v0 in r0, and
+ * the ones of the 16 bytes in the high lane in r1 */
+static always_inline void encode_hex_avx2(__m256i v0, __m256i *r0, __m256i *r1, const uint8_t *tab) {
+    __m256i ct = _mm256_loadu_si256       (as_m256c(tab));
+    __m256i nm = _mm256_set1_epi8         (0x0f);
+    __m256i hi = _mm256_and_si256         (_mm256_srli_epi16(v0, 4), nm);
+    __m256i lo = _mm256_and_si256         (v0, nm);
+    __m256i hc = _mm256_shuffle_epi8      (ct, hi);
+    __m256i lc = _mm256_shuffle_epi8      (ct, lo);
+    __m256i c0 = _mm256_unpacklo_epi8     (hc, lc);
+    __m256i c1 = _mm256_unpackhi_epi8     (hc, lc);
+    *r0 = _mm256_permute2x128_si256(c0, c1, 0x20);
+    *r1 = _mm256_permute2x128_si256(c0, c1, 0x31);
+}
+
+/** Function Implementations **/
+
+static always_inline void do_hexencode(struct slice_t *out, const struct slice_t *src, int mode) {
+    char *          ob = out->buf + out->len;
+    char *          op = out->buf + out->len;
+    const uint8_t * ip = as_m8c(src->buf);
+    const uint8_t * ie = as_m8c(src->buf) + src->len;
+    const char *    st = TabEncodeCharsetHexLower;
+    const uint8_t * vt = VecEncodeCharsetHexLower;
+
+    /* check for uppercase letters */
+    if (mode & MODE_UPPER) {
+        st = TabEncodeCharsetHexUpper;
+        vt = VecEncodeCharsetHexUpper;
+    }
+
+    /* SIMD 32 bytes loop */
+#ifdef __AVX2__
+    while (ip <= ie - 32) {
+        __m256i r0, r1;
+        encode_hex_avx2(_mm256_loadu_si256(as_m256c(ip)), &r0, &r1, vt);
+
+        /* store the result, and advance buffer pointers */
+        _mm256_storeu_si256(as_m256p(op), r0);
+        _mm256_storeu_si256(as_m256p(op + 32), r1);
+        op += 64;
+        ip += 32;
+    }
+
+    /* can do one more 16 bytes round, in the low lane only */
+    if (ip <= ie - 16) {
+        __m256i r0, r1;
+        encode_hex_avx2(_mm256_castsi128_si256(_mm_loadu_si128(as_m128c(ip))), &r0, &r1, vt);
+
+        /* store the result, and advance buffer pointers */
+        _mm256_storeu_si256(as_m256p(op), r0);
+        op += 32;
+        ip += 16;
+    }
+#endif
+
+    /* handle the remaining bytes with scalar code */
+    while (ip < ie) {
+        op[0] = st[*ip >> 4];
+        op[1] = st[*ip & 0x0f];
+        op += 2;
+        ip += 1;
+    }
+
+    /* update the result length */
+    out->len += op - ob;
+}
+
+/** Decoder Helper Functions **/
+
+static const uint8_t VecDecodeCharsetHex[256] = {
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+       0,    1,    2,    3,    4,    5,    6,    7,    8,    9, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff,   10,   11,   12,   13,   14,   15, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff,   10,   11,   12,   13,   14,   15, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff
+};
+
+/* Returns the 16 bytes of the 32 characters in v0 in 16-bit words, and if
+ * all the characters are valid in *ok */
+static always_inline __m256i decode_hex_avx2(__m256i v0, int *ok) {
+    __m256i lc = _mm256_or_si256      (v0, _mm256_set1_epi8(0x20));
+    __m256i d0 = _mm256_cmpgt_epi8    (v0, _mm256_set1_epi8('0' - 1));
+    __m256i d1 = _mm256_cmpgt_epi8    (_mm256_set1_epi8('9' + 1), v0);
+    __m256i a0 = _mm256_cmpgt_epi8    (lc, _mm256_set1_epi8('a' - 1));
+    __m256i a1 = _mm256_cmpgt_epi8    (_mm256_set1_epi8('f' + 1), lc);
+    __m256i dm = _mm256_and_si256     (d0, d1);
+    __m256i am = _mm256_and_si256     (a0, a1);
+    __m256i vm = _mm256_or_si256      (dm, am);
+    __m256i nv = _mm256_and_si256     (v0, _mm256_set1_epi8(0x0f));
+    __m256i av = _mm256_and_si256     (am, _mm256_set1_epi8(9));
+    __m256i vv = _mm256_add_epi8      (nv, av);
+    __m256i rv = _mm256_maddubs_epi16 (vv, _mm256_set1_epi16(0x0110));
+    return (*ok = _mm256_movemask_epi8(vm) == -1), rv;
+}
+
+static always_inline ssize_t do_hexdecode(struct slice_t *out, const char *src, size_t nb, int mode) {
+    int ok0;
+    int ok1;
+
+    /* output buffer */
+    char *ob = out->buf + out->len;
+    char *op = out->buf + out->len;
+    char *oe = out->buf + out->cap;
+
+    /* input buffer */
+    const uint8_t *st = VecDecodeCharsetHex;
+    const uint8_t *ib = (const uint8_t *)src;
+    const uint8_t *ip = (const uint8_t *)src;
+    const uint8_t *ie = (const uint8_t *)src + nb;
+
+#ifdef USE_AVX2
+    /* decode every 64 characters, and stop at the first invalid one */
+    while ((ip <= ie - 64) && (op <= oe - 32)) {
+        __m256i r0 = decode_hex_avx2(_mm256_loadu_si256(as_m256c(ip)), &ok0);
+        __m256i r1 = decode_hex_avx2(_mm256_loadu_si256(as_m256c(ip + 32)), &ok1);
+
+        /* the scalar code finds the error */
+        if (!ok0 || !ok1) {
+            break;
+        }
+
+        /* pack the words, and store the result */
+        __m256i vv = _mm256_packus_epi16(r0, r1);
+        _mm256_storeu_si256(as_m256p(op), _mm256_permute4x64_epi64(vv, 0xd8));
+
+        /* move to next block */
+        ip += 64;
+        op += 32;
+    }
+
+    /* can do one more 32 characters round */
+    if ((ip <= ie - 32) && (op <= oe - 16)) {
+        __m256i r0 = decode_hex_avx2(_mm256_loadu_si256(as_m256c(ip)), &ok0);
+
+        /* store the low lane only if all the characters are valid */
+        if (ok0) {
+            __m256i vv = _mm256_permute4x64_epi64(_mm256_packus_epi16(r0, r0), 0xd8);
+            _mm_storeu_si128(as_m128p(op), _mm256_castsi256_si128(vv));
+            ip += 32;
+            op += 16;
+        }
+    }
+#endif
+
+    /* handle the remaining characters with scalar code, one pair at a time */
+    while (ip <= ie - 2) {
+        uint8_t v0 = st[ip[0]];
+        uint8_t v1 = st[ip[1]];
+
+        /* check for invalid bytes */
+        if (v0 == 0xff) {
+            return ib - ip - 1;
+        } else if (v1 == 0xff) {
+            return ib - ip - 2;
+        }
+
+        /* store the result, and move to next pair */
+        *op++ = (v0 << 4) | v1;
+        ip += 2;
+    }
+
+    /* the odd character, invalid bytes take precedence, otherwise the
+     * error is reported at the end of the input */
+    if (ip != ie) {
+        if (st[ip[0]] == 0xff) {
+            return ib - ip - 1;
+        } else {
+            return ib - ie - 1;
+        }
+    }
+
+    /* update the result length */
+    out->len += op - ob;
+    return op - ob;
+}
@@ -0,0 +1,5 @@
+#include "hex.h"
+
+ssize_t hexdecode(struct slice_t *out, const char *src, size_t nb, int mode) {
+    return do_hexdecode(out, src, nb, mode);
+}
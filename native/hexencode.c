@@ -0,0 +1,5 @@
+#include "hex.h"
+
+void hexencode(struct slice_t *out, const struct slice_t *src, int mode) {
+     do_hexencode(out, src, mode);
+}
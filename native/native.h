@@ -5,6 +5,8 @@
 #define MODE_URL        1
 #define MODE_RAW        2
 #define MODE_JSON       8
+#define MODE_ANY        16
+#define MODE_UNMIXED    32
 
 #define as_m32v(v)      (*(uint32_t *)(v))
 #define as_m64v(v)      (*(uint64_t *)(v))
@@ -243,6 +245,29 @@ static const uint8_t VecDecodeCharsetURL[256] = {
     0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff
 };
 
+/* Both alphabets at once, for the lenient modes. The SIMD decoder can not
+ * have such a table, since it has one shift for every high nibble, and a
+ * single character with its own, so the URL-safe characters are mapped to
+ * the standard ones before using VecDecodeTableStd instead. */
+static const uint8_t VecDecodeCharsetAny[256] = {
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,   62, 0xff,   62, 0xff,   63,
+      52,   53,   54,   55,   56,   57,   58,   59,   60,   61, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff,    0,    1,    2,    3,    4,    5,    6,    7,    8,    9,   10,   11,   12,   13,   14,
+      15,   16,   17,   18,   19,   20,   21,   22,   23,   24,   25, 0xff, 0xff, 0xff, 0xff,   63,
+    0xff,   26,   27,   28,   29,   30,   31,   32,   33,   34,   35,   36,   37,   38,   39,   40,
+      41,   42,   43,   44,   45,   46,   47,   48,   49,   50,   51, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+    0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff
+};
+
 static always_inline void memcopy_24(char *dp, const uint8_t *sp) {
     *(uint64_t *)(dp +  0) = *(const uint64_t *)(sp +  0);
     *(uint64_t *)(dp +  8) = *(const uint64_t *)(sp +  8);
@@ -278,6 +303,26 @@ static always_inline __m256i decode_avx2(__m256i v0, int *pos, const uint8_t *ta
 }
 
 
+static always_inline __m256i normalize_any_avx2(__m256i v0) {
+    __m256i m0 = _mm256_cmpeq_epi8  (v0, _mm256_set1_epi8('-'));
+    __m256i m1 = _mm256_cmpeq_epi8  (v0, _mm256_set1_epi8('_'));
+    __m256i r0 = _mm256_blendv_epi8 (v0, _mm256_set1_epi8('+'), m0);
+    __m256i r1 = _mm256_blendv_epi8 (r0, _mm256_set1_epi8('/'), m1);
+    return r1;
+}
+
+/* Checks if the first of "+/-_" in the input is URL-safe, which selects
+ * the alphabet of the whole input in the unmixed lenient mode */
+static always_inline int is_url_first(const uint8_t *ip, const uint8_t *ie) {
+    for (; ip < ie; ip++) {
+        switch (*ip) {
+            case '+': case '/': return 0;
+            case '-': case '_': return 1;
+        }
+    }
+    return 0;
+}
+
 #define ALL_01h     (~0ul / 255)
 #define ALL_7fh     (ALL_01h * 127)
 #define ALL_80h     (ALL_01h * 128)
@@ -448,12 +493,33 @@ static always_inline ssize_t do_b64decode(struct slice_t *out, const char *src,
         st = VecDecodeCharsetURL;
     }
 
+    /* check for the lenient modes, with either alphabet, or the one of
+     * the first character that differs between them */
+    if ((mode & MODE_ANY) && !(mode & MODE_UNMIXED)) {
+        dt = VecDecodeTableStd;
+        st = VecDecodeCharsetAny;
+    } else if (mode & MODE_ANY) {
+        if (is_url_first(ip, ie)) {
+            dt = VecDecodeTableURL;
+            st = VecDecodeCharsetURL;
+        } else {
+            dt = VecDecodeTableStd;
+            st = VecDecodeCharsetStd;
+        }
+    }
+
 #ifdef USE_AVX2
     /* decode every 32 bytes, the final round should be handled separately, because the
      * SIMD instruction performs 32-byte store, and it might store past the end of the
      * output buffer */
     while ((ip <= ie - 32) && (op <= oe - 32)) {
         vv = _mm256_loadu_si256(as_m256c(ip));
+
+        /* map the URL-safe characters in the lenient mode */
+        if (st == VecDecodeCharsetAny) {
+            vv = normalize_any_avx2(vv);
+        }
+
         vv = decode_avx2(vv, &ep, dt);
 
         /* check for invalid characters (or '=' paddings) */
@@ -553,3 +619,147 @@ scalar:
     out->len += op - ob;
     return op - ob;
 }
+
+/** Batch Functions **/
+
+static always_inline void do_b64encbatch(struct slice_t *out, const struct slice_t *srcs, size_t nb, int64_t *offs, int mode) {
+    for (size_t i = 0; i < nb; i++) {
+        if (srcs[i].len != 0) {
+            do_b64encode(out, &srcs[i], mode);
+        }
+        offs[i] = out->len;
+    }
+}
+
+/* Every invalid item has its offset replaced by the error position as in
+ * b64decode, and is decoded as empty. Returns the number of them. */
+static always_inline ssize_t do_b64decbatch(struct slice_t *out, const struct slice_t *srcs, size_t nb, int64_t *offs, int mode) {
+    ssize_t rv;
+    ssize_t ne = 0;
+
+    /* decode every item into the output */
+    for (size_t i = 0; i < nb; i++) {
+        if ((rv = do_b64decode(out, srcs[i].buf, srcs[i].len, mode)) >= 0) {
+            offs[i] = out->len;
+        } else {
+            offs[i] = rv;
+            ne++;
+        }
+    }
+
+    /* the number of invalid items */
+    return ne;
+}
+
+/** Transcoder Functions **/
+
+/* Maps the characters in v0 from the alphabet of tab to the one of tc,
+ * and returns the position of the first invalid character in *pos */
+static always_inline __m256i transcode_avx2(__m256i v0, int *pos, const uint8_t *tab, const char *ts, const char *tc) {
+    __m256i m0 = _mm256_cmpeq_epi8  (v0, _mm256_set1_epi8(ts[62]));
+    __m256i m1 = _mm256_cmpeq_epi8  (v0, _mm256_set1_epi8(ts[63]));
+    __m256i r0 = _mm256_blendv_epi8 (v0, _mm256_set1_epi8(tc[62]), m0);
+    __m256i r1 = _mm256_blendv_epi8 (r0, _mm256_set1_epi8(tc[63]), m1);
+    return decode_avx2(v0, pos, tab), r1;
+}
+
+/* Re-encodes a well-formed input from one encoding to another without
+ * decoding it, by mapping the alphabets and fixing the paddings. Returns
+ * the output length, or -1 for anything else, which is left to the
+ * decoder and encoder to handle. */
+static always_inline ssize_t do_b64transcode(struct slice_t *out, const char *src, size_t nb, int from, int to) {
+    int     ep;
+    uint8_t sv;
+    size_t  nd;
+    size_t  ns = nb;
+
+    /* alphabets of the input and output */
+    const uint8_t *dt = VecDecodeTableStd;
+    const uint8_t *st = VecDecodeCharsetStd;
+    const char    *ts = TabEncodeCharsetStd;
+    const char    *tc = TabEncodeCharsetStd;
+
+    /* check for URL encodings */
+    if (from & MODE_URL) {
+        dt = VecDecodeTableURL;
+        st = VecDecodeCharsetURL;
+        ts = TabEncodeCharsetURL;
+    }
+    if (to & MODE_URL) {
+        tc = TabEncodeCharsetURL;
+    }
+
+    /* strip the paddings, they must complete the last group */
+    if (!(from & MODE_RAW)) {
+        if (ns % 4 != 0) {
+            return -1;
+        } else if (ns != 0 && src[ns - 1] == '=') {
+            if (src[--ns - 1] == '=') {
+                ns--;
+            }
+        }
+    }
+
+    /* a single character can not make a byte */
+    if (ns % 4 == 1) {
+        return -1;
+    }
+
+    /* the output length */
+    if (to & MODE_RAW) {
+        nd = ns;
+    } else {
+        nd = (ns + 3) / 4 * 4;
+    }
+
+    /* check for output buffer */
+    if (out->cap - out->len < nd) {
+        return -1;
+    }
+
+    /* buffer pointers */
+    char          *op = out->buf + out->len;
+    const uint8_t *ip = (const uint8_t *)src;
+    const uint8_t *ie = (const uint8_t *)src + ns;
+
+#ifdef USE_AVX2
+    /* map every 32 characters */
+    while (ip <= ie - 32) {
+        __m256i vv = transcode_avx2(_mm256_loadu_si256(as_m256c(ip)), &ep, dt, ts, tc);
+
+        /* check for invalid characters */
+        if (ep < 32) {
+            return -1;
+        }
+
+        /* store the result, and move to next block */
+        _mm256_storeu_si256(as_m256p(op), vv);
+        ip += 32;
+        op += 32;
+    }
+#endif
+
+    /* map the remaining characters */
+    while (ip < ie) {
+        if ((sv = st[*ip++]) == 0xff) {
+            return -1;
+        } else {
+            *op++ = tc[sv];
+        }
+    }
+
+    /* clear the unused bits of the last character, as the encoder does */
+    switch (ns % 4) {
+        case 2: op[-1] = tc[st[ie[-1]] & 0x30]; break;
+        case 3: op[-1] = tc[st[ie[-1]] & 0x3c]; break;
+    }
+
+    /* add the paddings */
+    while (ns++ < nd) {
+        *op++ = '=';
+    }
+
+    /* update the result length */
+    out->len += nd;
+    return nd;
+}
@@ -0,0 +1,190 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+)
+
+// OptionalPadding returns an encoding like self, except it decodes both
+// padded and unpadded inputs, so "Zg==" and "Zg" are both accepted.
+// Paddings that are present must be complete and end the input, and
+// malformed ones are reported at the same offsets as encoding/base64.
+// The encoders are unaffected.
+func (self Encoding) OptionalPadding() Encoding {
+    return self | _MODE_OPTPAD
+}
+
+// IsPaddingOptional reports whether self decodes unpadded inputs as
+// well as padded ones.
+func (self Encoding) IsPaddingOptional() bool {
+    return (self & _MODE_OPTPAD) != 0
+}
+
+// decodePadding checks the paddings in Go, and decodes the characters
+// before them with the native decoder, in raw mode.
+func (self Encoding) decodePadding(out *[]byte, src []byte) (int, error) {
+    raw := self &^ _MODE_OPTPAD | _MODE_RAW
+    pos, nc := self.indexPadding(src)
+
+    /* not padded at all */
+    if pos < 0 {
+        return raw.DecodeUnsafe(out, src)
+    }
+
+    /* the paddings can only follow 2 or 3 characters of the last group */
+    if nc % 4 < 2 {
+        return 0, self.corruptBefore(src, pos)
+    }
+
+    /* decode the characters before the paddings */
+    nb := len(*out)
+    ret, err := raw.DecodeUnsafe(out, src[:pos])
+    if err != nil {
+        return 0, self.corruptBefore(src, pos)
+    }
+
+    /* check the paddings, in the same way as encoding/base64 does */
+    if err = self.checkPadding(src, pos, 4 - nc % 4); err != nil {
+        *out = (*out)[:nb]
+        return 0, err
+    } else {
+        return ret, nil
+    }
+}
+
+// indexPadding returns the offset of the first padding in src, or -1,
+// and the number of characters before it, new lines excluded.
+func (self Encoding) indexPadding(src []byte) (int, int) {
+    if (self & _MODE_JSON) == 0 || bytes.IndexByte(src, '\\') < 0 {
+        if pos := bytes.IndexByte(src, '='); pos < 0 {
+            return -1, 0
+        } else {
+            return pos, pos - bytes.Count(src[:pos], []byte{'\r'}) - bytes.Count(src[:pos], []byte{'\n'})
+        }
+    }
+
+    /* the paddings might be escaped */
+    nc := 0
+    for i := 0; i < len(src); {
+        ch, next := self.readChar(src, i)
+        if ch == '=' {
+            return i, nc
+        }
+        if ch != '\r' && ch != '\n' {
+            nc++
+        }
+        i = next
+    }
+    return -1, 0
+}
+
+// checkPadding checks that the first padding at pos is followed by n-1
+// more, and then by nothing but new lines.
+func (self Encoding) checkPadding(src []byte, pos int, n int) error {
+    _, i := self.readChar(src, pos)
+
+    /* the remaining paddings, new lines may come in between */
+    for ; n > 1; n-- {
+        j := self.skipNewlines(src, i)
+        if j == len(src) {
+            return base64.CorruptInputError(len(src))
+        }
+        ch, next := self.readChar(src, j)
+        if ch != '=' {
+            return base64.CorruptInputError(j - 1)
+        }
+        i = next
+    }
+
+    /* nothing but new lines may follow */
+    if j := self.skipNewlines(src, i); j != len(src) {
+        return base64.CorruptInputError(j)
+    } else {
+        return nil
+    }
+}
+
+// skipNewlines returns the offset of the first character in src at or
+// after i that is not a new line.
+func (self Encoding) skipNewlines(src []byte, i int) int {
+    for i < len(src) {
+        if ch, next := self.readChar(src, i); ch != '\r' && ch != '\n' {
+            break
+        } else {
+            i = next
+        }
+    }
+    return i
+}
+
+// corruptBefore returns the error for src, which is invalid before the
+// padding at pos, at the first invalid character, or at pos.
+func (self Encoding) corruptBefore(src []byte, pos int) error {
+    st := self.decodeTable(src[:pos])
+
+    /* find the first invalid character */
+    for i := 0; i < pos; {
+        ch, next := self.readChar(src, i)
+        if ch != '\r' && ch != '\n' && st[ch] == 0xff {
+            return base64.CorruptInputError(i)
+        }
+        i = next
+    }
+    return base64.CorruptInputError(pos)
+}
+
+// readChar returns the character at src[i] with the JSON escapes
+// resolved like the native decoder does, that is 0xff for the invalid
+// ones, and the offset of the next character.
+func (self Encoding) readChar(src []byte, i int) (byte, int) {
+    if ch := src[i]; ch != '\\' || (self & _MODE_JSON) == 0 {
+        return ch, i + 1
+    } else if i + 1 == len(src) {
+        return 0xff, i + 1
+    }
+
+    /* unescape the character */
+    switch src[i + 1] {
+        case 'r' : return '\r', i + 2
+        case 'n' : return '\n', i + 2
+        case '/' : return '/', i + 2
+        case 'u' : break
+        default  : return 0xff, i + 2
+    }
+
+    /* \uXXXX, for ASCII characters only */
+    if i + 6 > len(src) {
+        return 0xff, i + 2
+    }
+    ch := 0
+    for _, c := range src[i + 2:i + 6] {
+        switch {
+            case c >= '0' && c <= '9' : ch = ch << 4 | int(c - '0')
+            case c >= 'a' && c <= 'f' : ch = ch << 4 | int(c - 'a' + 10)
+            case c >= 'A' && c <= 'F' : ch = ch << 4 | int(c - 'A' + 10)
+            default                   : return 0xff, i + 2
+        }
+    }
+    if ch < 128 {
+        return byte(ch), i + 6
+    } else {
+        return 0xff, i + 6
+    }
+}
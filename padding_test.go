@@ -0,0 +1,156 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+func TestOptionalPadding(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 100; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* both padded and unpadded inputs */
+        for _, tt := range encodingTests {
+            enc := tt.enc.OptionalPadding()
+            for _, in := range []Encoding{tt.enc &^ _MODE_RAW, tt.enc | _MODE_RAW} {
+                encoded := in.EncodeToString(src)
+                dec, err := enc.DecodeString(encoded)
+                if err != nil || !bytes.Equal(dec, src) {
+                    t.Fatalf("DecodeString(%q) = %x, %v, want %x", encoded, dec, err, src)
+                }
+            }
+
+            /* the encoders are unaffected */
+            testEqual(t, "EncodeToString(%x) = %q, want %q", src, enc.EncodeToString(src), tt.enc.EncodeToString(src))
+        }
+    }
+}
+
+func TestOptionalPaddingErrors(t *testing.T) {
+    enc := StdEncoding.OptionalPadding()
+    for _, tt := range []struct {
+        src string
+        out string
+        pos int
+    }{
+        {"Zg==", "f", -1},
+        {"Zg", "f", -1},
+        {"Zm9=", "fo", -1},
+        {"Zm9", "fo", -1},
+        {"Zg=\r\n=\r\n", "f", -1},
+        {"Zm\r\n9v\r\nYg", "foob", -1},
+        {"Zg=", "", 3},
+        {"Zg=A", "", 2},
+        {"Zg=\nA", "", 3},
+        {"Zg===", "", 4},
+        {"Zm9==", "", 4},
+        {"Zm9v=", "", 4},
+        {"Zm9vZ=", "", 5},
+        {"Z===", "", 1},
+        {"=", "", 0},
+        {"Zg==Zg==", "", 4},
+        {"Zg==\nA", "", 5},
+        {"Z!==", "", 1},
+        {"Zm!v=", "", 2},
+    } {
+        var want error
+        if tt.pos >= 0 {
+            want = base64.CorruptInputError(tt.pos)
+        }
+        dec, err := enc.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, want)
+        testEqual(t, "DecodeString(%q) = %q, want %q", tt.src, string(dec), tt.out)
+    }
+}
+
+func TestOptionalPaddingStdlib(t *testing.T) {
+    var gen func(string, int)
+    enc := StdEncoding.OptionalPadding()
+
+    /* every short input with paddings, compared with encoding/base64 */
+    gen = func(s string, n int) {
+        if strings.Contains(s, "=") {
+            want, werr := base64.StdEncoding.DecodeString(s)
+            dec, err := enc.DecodeString(s)
+            testEqual(t, "DecodeString(%q) = error %v, want %v", s, err, werr)
+            if werr == nil {
+                testEqual(t, "DecodeString(%q) = %q, want %q", s, string(dec), string(want))
+            }
+        }
+        if n != 0 {
+            for _, c := range "Zg=\n!" {
+                gen(s + string(c), n - 1)
+            }
+        }
+    }
+    gen("", 7)
+}
+
+func TestOptionalPaddingJSON(t *testing.T) {
+    enc := JSONStdEncoding.OptionalPadding()
+    for _, tt := range []struct {
+        src string
+        out string
+        pos int
+    }{
+        {`Zg\u003d\u003D`, "f", -1},
+        {`Zg=\u003d`, "f", -1},
+        {`Z\/8`, "g\xff", -1},
+        {`Z\/8\u003d`, "g\xff", -1},
+        {`Zg\u003d`, "", 8},
+        {`Zg\u003dA`, "", 7},
+        {`Zg\u003d=\u003d`, "", 9},
+        {`Zg==\n\r`, "f", -1},
+        {`Zg==\nA`, "", 6},
+    } {
+        var want error
+        if tt.pos >= 0 {
+            want = base64.CorruptInputError(tt.pos)
+        }
+        dec, err := enc.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, want)
+        testEqual(t, "DecodeString(%q) = %q, want %q", tt.src, string(dec), tt.out)
+    }
+}
+
+func TestOptionalPaddingLenient(t *testing.T) {
+    for _, enc := range []Encoding{
+        StdEncoding.OptionalPadding().Lenient(),
+        RawURLEncoding.Lenient().OptionalPadding(),
+    } {
+        for _, src := range []string{"-_8=", "+/8", "+_8="} {
+            dec, err := enc.DecodeString(src)
+            testEqual(t, "DecodeString(%q) = error %v, want %v", src, err, error(nil))
+            testEqual(t, "DecodeString(%q) = %q, want %q", src, string(dec), "\xfb\xff")
+        }
+        _, err := enc.DecodeString("-_8==")
+        testEqual(t, "DecodeString() = error %v, want %v", err, error(base64.CorruptInputError(4)))
+    }
+
+    /* the accessors */
+    testEqual(t, "IsPaddingOptional() = %v, want %v", StdEncoding.IsPaddingOptional(), false)
+    testEqual(t, "IsPaddingOptional() = %v, want %v", URLEncoding.OptionalPadding().IsPaddingOptional(), true)
+    testEqual(t, "DecodedLen(2) = %v, want %v", StdEncoding.OptionalPadding().DecodedLen(2), 1)
+}
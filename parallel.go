@@ -0,0 +1,167 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `encoding/base64`
+    `runtime`
+    `sync`
+)
+
+// Inputs are only split into parts of at least this many bytes, since
+// smaller ones do not pay for the goroutines.
+const (
+    _PARALLEL_MIN = 256 * 1024
+)
+
+// partition returns the size of the parts to split n bytes into, which
+// is a multiple of unit, or 0 if n is too small to be split.
+func partition(n int, unit int) int {
+    np := runtime.GOMAXPROCS(0)
+    if n / _PARALLEL_MIN < np {
+        np = n / _PARALLEL_MIN
+    }
+
+    /* check for small inputs */
+    if np < 2 {
+        return 0
+    }
+
+    /* round the part size up to the unit */
+    nb := (n + np - 1) / np
+    return (nb + unit - 1) / unit * unit
+}
+
+// EncodeParallel behaves like Encode, except large inputs are split on
+// 3-byte boundaries and encoded concurrently into disjoint ranges of out.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) EncodeParallel(out []byte, src []byte) {
+    nb := partition(len(src), 3)
+    if nb == 0 {
+        self.Encode(out, src)
+        return
+    }
+
+    /* check for the output size */
+    if self.EncodedLen(len(src)) > len(out) {
+        panic("encoder output buffer is too small")
+    }
+
+    /* encode every part, only the last one may need paddings */
+    wg := sync.WaitGroup{}
+    for i := 0; i < len(src); i += nb {
+        wg.Add(1)
+        go func(p []byte, op int) {
+            buf := out[op:op:len(out)]
+            self.EncodeUnsafe(&buf, p)
+            wg.Done()
+        }(src[i:minInt(i + nb, len(src))], i / 3 * 4)
+    }
+
+    /* wait for all the parts */
+    wg.Wait()
+}
+
+// DecodeParallel behaves like Decode, except large inputs are split on
+// 4-character boundaries and decoded concurrently into disjoint ranges
+// of out.
+//
+// Parts with new lines, JSON escapes or paddings in them do not decode
+// into whole groups, so the input is decoded sequentially from the first
+// such part on. As a result, the output and the offset of the
+// CorruptInputError are the same as Decode, and the error is the
+// earliest one in the whole input.
+//
+// Encodings returned by LenientUnmixed are decoded sequentially, since
+// the alphabets are checked across the whole input.
+//
+// If out is not large enough to contain the decoded result,
+// it will panic.
+func (self Encoding) DecodeParallel(out []byte, src []byte) (int, error) {
+    nb := partition(len(src), 4)
+    if nb == 0 || (self & _MODE_UNMIXED) != 0 {
+        return self.Decode(out, src)
+    }
+
+    /* check for the output size */
+    if self.DecodedLen(len(src)) > len(out) {
+        panic("decoder output buffer is too small")
+    }
+
+    /* the results of every part */
+    np := (len(src) + nb - 1) / nb
+    rn := make([]int, np)
+    re := make([]error, np)
+
+    /* decode every part, with the output limited to the whole groups */
+    wg := sync.WaitGroup{}
+    for i := 0; i < np; i++ {
+        wg.Add(1)
+        go func(i int) {
+            ip := i * nb
+            op := ip / 4 * 3
+            ie := minInt(ip + nb, len(src))
+            oe := ie / 4 * 3
+
+            /* the last part may have a partial group */
+            if i == np - 1 {
+                oe = len(out)
+            }
+
+            /* decode into the range of the part */
+            buf := out[op:op:oe]
+            rn[i], re[i] = self.DecodeUnsafe(&buf, src[ip:ie])
+            wg.Done()
+        }(i)
+    }
+
+    /* wait for all the parts */
+    wg.Wait()
+
+    /* find the first part that is not made of whole groups */
+    i := 0
+    for i < np - 1 && re[i] == nil && rn[i] == nb / 4 * 3 {
+        i++
+    }
+
+    /* all the parts are good, the last one may be shorter */
+    if i == np - 1 && re[i] == nil {
+        return i * nb / 4 * 3 + rn[i], nil
+    }
+
+    /* decode the rest sequentially */
+    ip := i * nb
+    op := ip / 4 * 3
+    buf := out[op:op:len(out)]
+
+    /* the previous parts end on group boundaries */
+    if n, err := self.DecodeUnsafe(&buf, src[ip:]); err != nil {
+        return 0, err.(base64.CorruptInputError) + base64.CorruptInputError(ip)
+    } else {
+        return op + n, nil
+    }
+}
+
+func minInt(a int, b int) int {
+    if a < b {
+        return a
+    } else {
+        return b
+    }
+}
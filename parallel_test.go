@@ -0,0 +1,110 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `runtime`
+    `strings`
+    `testing`
+)
+
+func withProcs(n int, fn func()) {
+    old := runtime.GOMAXPROCS(n)
+    defer runtime.GOMAXPROCS(old)
+    fn()
+}
+
+func TestEncodeParallel(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    withProcs(8, func() {
+        for _, n := range []int{0, 100, _PARALLEL_MIN * 2, _PARALLEL_MIN * 5 + 1, _PARALLEL_MIN * 9 + 2} {
+            src := make([]byte, n)
+            rng.Read(src)
+            for _, tt := range encodingTests {
+                out := make([]byte, tt.enc.EncodedLen(n))
+                tt.enc.EncodeParallel(out, src)
+                if string(out) != tt.enc.EncodeToString(src) {
+                    t.Errorf("EncodeParallel(%d bytes) mismatch for %d", n, tt.enc)
+                }
+            }
+        }
+    })
+}
+
+func TestDecodeParallel(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    withProcs(8, func() {
+        for _, n := range []int{0, 100, _PARALLEL_MIN * 2, _PARALLEL_MIN * 5 + 1, _PARALLEL_MIN * 9 + 2} {
+            src := make([]byte, n)
+            rng.Read(src)
+            for _, tt := range encodingTests {
+                enc := []byte(tt.enc.EncodeToString(src))
+                out := make([]byte, tt.enc.DecodedLen(len(enc)))
+                nb, err := tt.enc.DecodeParallel(out, enc)
+                if err != nil || !bytes.Equal(out[:nb], src) {
+                    t.Errorf("DecodeParallel(%d bytes) = %v for %d", n, err, tt.enc)
+                }
+            }
+        }
+
+        /* new lines shift the groups */
+        src := make([]byte, _PARALLEL_MIN * 3)
+        rng.Read(src)
+        enc := []byte(StdEncoding.EncodeToString(src))
+        enc = append(enc[:len(enc) / 2], append([]byte("\r\n"), enc[len(enc) / 2:]...)...)
+        out := make([]byte, StdEncoding.DecodedLen(len(enc)))
+        nb, err := StdEncoding.DecodeParallel(out, enc)
+        if err != nil || !bytes.Equal(out[:nb], src) {
+            t.Errorf("DecodeParallel() = %v", err)
+        }
+    })
+}
+
+func TestDecodeParallelError(t *testing.T) {
+    withProcs(8, func() {
+        src := []byte(strings.Repeat("Zm9v", _PARALLEL_MIN * 2))
+        out := make([]byte, StdEncoding.DecodedLen(len(src)))
+
+        /* the earliest error wins */
+        for _, pos := range [][]int{
+            {len(src) - 10},
+            {len(src) / 2 + 1, len(src) - 10},
+            {_PARALLEL_MIN + 5, len(src) / 2, len(src) - 10},
+            {100, len(src) - 10},
+        } {
+            buf := append([]byte(nil), src...)
+            for _, p := range pos {
+                buf[p] = '!'
+            }
+            _, err := StdEncoding.DecodeParallel(out, buf)
+            testEqual(t, "DecodeParallel() = error %v, want %v", err, error(base64.CorruptInputError(pos[0])))
+        }
+
+        /* paddings in the middle */
+        buf := append([]byte(nil), src...)
+        copy(buf[len(buf) / 2:], "Zg==")
+        _, err := StdEncoding.DecodeParallel(out, buf)
+        _, want := StdEncoding.Decode(out, buf)
+        testEqual(t, "DecodeParallel() = error %v, want %v", err, want)
+        if err == nil {
+            t.Errorf("DecodeParallel() accepted paddings in the middle")
+        }
+    })
+}
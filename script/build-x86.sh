@@ -22,6 +22,16 @@ CLAGS=(
     "-mno-sse4 -mavx -mpclmul -mavx2 -DUSE_AVX2=1" 
 )
 
+# GCC builds objects instead, which are converted by script/obj2go.py, so
+# neither clang nor tools/asm2asm is needed. The stack must not be realigned
+# for the vectors, since the loader can not describe that in the PC->SP table.
+GCC_CLAGS=(
+    "-msse2 -mno-sse3 -mno-ssse3 -mno-sse4 -mno-avx -mno-avx2"
+    "-mavx2 -DUSE_AVX2=1"
+)
+
+GCC_FLAGS="-O3 -fPIE -fno-omit-frame-pointer -mno-red-zone -fno-asynchronous-unwind-tables -fno-builtin -fno-exceptions -fno-stack-protector -fcf-protection=none -fno-jump-tables -fno-tree-loop-distribute-patterns -ffreestanding -nostdlib -Wno-psabi -mincoming-stack-boundary=5 -mpreferred-stack-boundary=5"
+
 i=0
 
 for arc in "${CPU_ARCS[@]}"; do
@@ -45,7 +55,15 @@ for arc in "${CPU_ARCS[@]}"; do
     for src_file in "$SRC_DIR"/*.c; do
         base_name=$(basename "$src_file" .c)
         asm_file="$tmp_dir/${base_name}.s"
-    
+        obj_file="$tmp_dir/${base_name}.o"
+
+        # Compile the source file with GCC, and convert the object file
+        if [[ "$(basename $CC)" == *gcc* ]]; then
+            $CC ${GCC_CLAGS[$i]} ${GCC_FLAGS} ${EXTRA_CLAGS} -c -o $obj_file $src_file
+            python3 script/obj2go.py $out_dir/${base_name}.go $obj_file
+            continue
+        fi
+
         # Compile the source file into an assembly file
         # -Wall -Werror 
         $CC ${CLAGS[$i]} -target x86_64-apple-macos11 -mno-red-zone -fno-asynchronous-unwind-tables -fno-builtin -fno-exceptions -fno-rtti -fno-stack-protector -nostdlib  ${EXTRA_CLAGS} -O3 -S -o $asm_file $src_file
@@ -0,0 +1,265 @@
+#!/usr/bin/env python3
+#
+# Copyright 2026 CloudWeGo Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+
+# obj2go converts an object file built by GCC from native/*.c into the
+# _text_amd64.go and _subr.go files read by the loader, in the same layout
+# as the ones asm2asm generates from the assembly of clang:
+#
+#     python3 script/obj2go.py internal/native/avx2/b64encode.go output/avx2/b64encode.o
+#
+# The object is linked at address 0 with all the constants placed before
+# the functions, so every reference to them stays PC-relative, and the
+# PC->SP table of every function is computed by walking its control flow.
+
+import os
+import re
+import subprocess
+import sys
+import tempfile
+
+LINKER_SCRIPT = '''
+SECTIONS {
+    . = 0;
+    .text : {
+        *(.rodata.cst32) *(.rodata.cst16) *(.rodata.cst8) *(.rodata.cst4) *(.rodata.cst2)
+        *(.rodata .rodata.*)
+        . = ALIGN(16);
+        *(.text .text.*)
+    }
+    /DISCARD/ : { *(.comment) *(.note.*) *(.data) *(.bss) }
+}
+'''
+
+RELOCS = {'R_X86_64_PC32', 'R_X86_64_PLT32'}
+BRANCH = re.compile(r'^(j[a-z]+|loop[a-z]*)$')
+STACK = re.compile(r'\((%rsp|%rbp)[,)]')
+UNALIGNED = re.compile(r'^v?(movdqu|movups|movupd|movq|movd|movss|movsd|movlps|movhps|movlpd|movhpd)$')
+INSN = re.compile(r'^\s*([0-9a-f]+):\s+((?:[0-9a-f]{2} )+)\s*(.*)$')
+
+def run(*args):
+    return subprocess.run(args, check = True, capture_output = True, text = True).stdout
+
+def fail(msg):
+    sys.exit('obj2go: ' + msg)
+
+def check_object(obj):
+    for line in run('readelf', '-rW', obj).splitlines():
+        fv = line.split()
+        if len(fv) >= 3 and fv[2].startswith('R_X86_64') and fv[2] not in RELOCS:
+            fail('%s: unsupported relocation %s' % (obj, fv[2]))
+    for line in run('readelf', '-SW', obj).splitlines():
+        fv = re.sub(r'^\s*\[\s*\d+\]', '', line).split()
+        if len(fv) >= 5 and fv[0] in ('.data', '.bss') and int(fv[4], 16) != 0:
+            fail('%s: writable data is not supported' % obj)
+
+def link(obj, tmp):
+    lds = os.path.join(tmp, 'link.ld')
+    elf = os.path.join(tmp, 'link.elf')
+    bin = os.path.join(tmp, 'link.bin')
+    with open(lds, 'w') as fp:
+        fp.write(LINKER_SCRIPT)
+    run('ld', '-nostdlib', '--no-undefined', '-e', '0', '-T', lds, '-o', elf, obj)
+    run('objcopy', '-O', 'binary', '-j', '.text', elf, bin)
+    with open(bin, 'rb') as fp:
+        return elf, fp.read()
+
+def symbols(elf):
+    ret = {}
+    for line in run('nm', '-n', elf).splitlines():
+        fv = line.split()
+        if len(fv) == 3 and fv[1] in 'TtRr':
+            ret[fv[2]] = int(fv[0], 16)
+    return ret
+
+def disasm(elf, start, end):
+    ret = []
+    out = run('objdump', '-d', '-z', '-M', 'suffix', '--insn-width=16', '-w',
+              '--start-address=%d' % start, '--stop-address=%d' % end, elf)
+    for line in out.splitlines():
+        m = INSN.match(line)
+        if m:
+            pc = int(m.group(1), 16)
+            code = bytes(int(v, 16) for v in m.group(2).split())
+            text = re.sub(r'\s+<[^>]*>', '', m.group(3)).strip()
+            ret.append((pc, code, text))
+    return ret
+
+def target(text):
+    m = re.match(r'^\S+\s+([0-9a-f]+)$', text)
+    return int(m.group(1), 16) if m else None
+
+def stack_effect(text, sp, fp):
+    op, _, args = text.partition(' ')
+    args = args.strip()
+    if op in ('pushq', 'push'):
+        return sp + 8, fp
+    if op in ('popq', 'pop'):
+        return sp - 8, fp
+    if op == 'leaveq' or op == 'leave':
+        return fp - 8, None
+    if args.endswith('%rsp') and op not in ('cmpq', 'testq'):
+        m = re.match(r'^\$(-?0x[0-9a-f]+|-?\d+),%rsp$', args)
+        if op == 'subq' and m:
+            return sp + int(m.group(1), 0), fp
+        if op == 'addq' and m:
+            return sp - int(m.group(1), 0), fp
+        m = re.match(r'^(-?0x[0-9a-f]+|-?\d+)?\(%rbp\),%rsp$', args)
+        if op == 'leaq' and m and fp is not None:
+            return fp - int(m.group(1) or '0', 0), fp
+        if op == 'movq' and args == '%rbp,%rsp' and fp is not None:
+            return fp, fp
+        fail('unsupported stack operation: ' + text)
+    if op == 'movq' and args == '%rsp,%rbp':
+        return sp, sp
+    if args.endswith('%rbp') and fp is not None and op not in ('cmpq', 'testq', 'movq'):
+        fail('unsupported frame pointer operation: ' + text)
+    return sp, fp
+
+def pcsp(name, insns):
+    index = {pc: i for i, (pc, _, _) in enumerate(insns)}
+    depth = {}
+    works = [(0, 0, None)]
+
+    # walk every path, the depth must agree at every instruction
+    while works:
+        i, sp, fp = works.pop()
+        while i < len(insns):
+            pc, _, text = insns[i]
+            if pc in depth:
+                if depth[pc] != (sp, fp):
+                    fail('%s: inconsistent stack depth at %#x' % (name, pc))
+                break
+            depth[pc] = (sp, fp)
+            op = text.split(' ')[0]
+            if op.startswith('call'):
+                fail('%s: calls are not supported: %s' % (name, text))
+            if STACK.search(text) and '%xmm' in text or '%ymm' in text and STACK.search(text):
+                if not UNALIGNED.match(op):
+                    fail('%s: the stack may not be aligned for vectors: %s' % (name, text))
+            if op.startswith('ret'):
+                if sp != 0:
+                    fail('%s: unbalanced stack at %#x' % (name, pc))
+                break
+            nsp, nfp = stack_effect(text, sp, fp)
+            if BRANCH.match(op):
+                to = target(text)
+                if to is None:
+                    fail('%s: indirect branch: %s' % (name, text))
+                works.append((index[to], nsp, nfp))
+                if op == 'jmp' or op == 'jmpq':
+                    break
+            sp, fp = nsp, nfp
+            i += 1
+
+    # the unreachable paddings keep the depth before them
+    ret, cur = [], 0
+    for pc, code, _ in insns:
+        val = depth.get(pc, (cur, None))[0]
+        if ret and ret[-1][1] == val:
+            ret[-1][0] = pc + len(code) - insns[0][0]
+        else:
+            ret.append([pc + len(code) - insns[0][0], val])
+        cur = val
+    return ret
+
+def labels(insns):
+    ret = {}
+    for _, _, text in insns:
+        if BRANCH.match(text.split(' ')[0]):
+            to = target(text)
+            if to is not None:
+                ret[to] = None
+    for i, pc in enumerate(sorted(ret)):
+        ret[pc] = 'LBB0_%d' % i
+    return ret
+
+def rename(text, lbs):
+    to = target(text) if BRANCH.match(text.split(' ')[0]) else None
+    if to is not None and to in lbs:
+        text = text.split(' ')[0] + ' ' + lbs[to]
+    op, _, args = text.partition(' ')
+    return '%-12s %s' % (op, args.strip()) if args else op
+
+def data_rows(buf, start, end, names):
+    ret, pc = [], start
+    while pc < end:
+        if pc in names:
+            ret.append('\t//0x%08x _%s' % (pc, names[pc]))
+        nb = 16 - pc % 16
+        nb = min(nb, end - pc, min([n - pc for n in names if n > pc] or [end - pc]))
+        row = buf[pc:pc + nb]
+        ret.append('\t%s //0x%08x .byte %s' % (' '.join('0x%02x,' % v for v in row), pc, ', '.join(str(v) for v in row)))
+        pc += nb
+    return ret
+
+def main():
+    if len(sys.argv) != 3:
+        fail('usage: obj2go.py <out.go> <object>')
+    out, obj = sys.argv[1:]
+    pkg = os.path.basename(os.path.dirname(os.path.abspath(out)))
+    base = os.path.splitext(out)[0]
+    name = os.path.basename(base)
+
+    # link the object at address 0
+    check_object(obj)
+    with tempfile.TemporaryDirectory() as tmp:
+        elf, buf = link(obj, tmp)
+        syms = symbols(elf)
+        if name not in syms:
+            fail('%s: function %s not found' % (obj, name))
+        entry = syms[name]
+        data = {v: k for k, v in syms.items() if v < entry}
+        insns = disasm(elf, entry, len(buf))
+
+    # the function runs to the end of the text
+    size = len(buf) - entry
+    sp = pcsp(name, insns)
+    lbs = labels(insns)
+
+    # the machine code, with the disassembly as comments
+    text = ['// +build amd64', '// Code generated by obj2go, DO NOT EDIT.', '', 'package ' + pkg, '']
+    text.append('var _text_%s = []byte{' % name)
+    text.extend(data_rows(buf, 0, entry, data))
+    text.append('\t//0x%08x _%s' % (entry, name))
+    for pc, code, ins in insns:
+        if pc in lbs:
+            text.append('\t//0x%08x %s' % (pc, lbs[pc]))
+        text.append('\t%s //0x%08x %s' % (' '.join('0x%02x,' % v for v in code), pc, rename(ins, lbs)))
+    text.append('}')
+    text.append('')
+
+    # the function table for the loader
+    subr = ['// +build !noasm !appengine', '// Code generated by obj2go, DO NOT EDIT.', '', 'package ' + pkg, '']
+    subr += ['import (', '\t`github.com/bytedance/sonic/loader`', ')', '']
+    subr += ['const (', '    _entry__%s = %d' % (name, entry), ')', '']
+    subr += ['const (', '    _stack__%s = %d' % (name, max(v for _, v in sp)), ')', '']
+    subr += ['const (', '    _size__%s = %d' % (name, size), ')', '']
+    subr += ['var (', '    _pcsp__%s = [][2]uint32{' % name]
+    subr += ['        {%#x, %d},' % (pc, v) for pc, v in sp]
+    subr += ['    }', ')', '']
+    subr += ['var _cfunc_%s = []loader.CFunc{' % name]
+    subr += ['    {"_%s_entry", 0,  _entry__%s, 0, nil},' % (name, name)]
+    subr += ['    {"_%s", _entry__%s, _size__%s, _stack__%s, _pcsp__%s},' % ((name,) * 5)]
+    subr += ['}', '']
+
+    with open(base + '_text_amd64.go', 'w') as fp:
+        fp.write('\n'.join(text))
+    with open(base + '_subr.go', 'w') as fp:
+        fp.write('\n'.join(subr))
+
+if __name__ == '__main__':
+    main()
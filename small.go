@@ -0,0 +1,197 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+// Inputs up to these sizes are handled in Go, since the cost of calling
+// into the native code dominates for them. The thresholds are chosen by
+// the Small benchmarks, which compare the Go versions with the native
+// calls alone.
+const (
+    _SMALL_ENCODE = 3
+    _SMALL_DECODE = 16
+)
+
+// decodeSmall takes up to this many characters, a bit more than needed,
+// so the benchmarks can measure it past _SMALL_DECODE.
+const (
+    _SMALL_DECODE_MAX = 24
+)
+
+const (
+    charsetStd = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+    charsetURL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+)
+
+var (
+    decodeTableStd = makeDecodeTable(charsetStd)
+    decodeTableURL = makeDecodeTable(charsetURL)
+    decodeTableAny = makeDecodeTableAny()
+)
+
+func makeDecodeTable(charset string) (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    return
+}
+
+// makeDecodeTableAny makes the table of both alphabets, as in the native
+// VecDecodeCharsetAny.
+func makeDecodeTableAny() (tab [256]byte) {
+    tab = makeDecodeTable(charsetStd)
+    tab['-'] = 62
+    tab['_'] = 63
+    return
+}
+
+// encodeSmall is the Go version of the native encoder.
+func (self Encoding) encodeSmall(out *[]byte, src []byte) {
+    nb := len(*out)
+    ne := self.EncodedLen(len(src))
+    st := charsetStd
+    buf := (*out)[nb:nb + ne]
+
+    /* check for URL encoding */
+    if (self & _MODE_URL) != 0 {
+        st = charsetURL
+    }
+
+    /* encode every 3 bytes into 4 characters */
+    for len(src) >= 3 {
+        v := uint(src[0]) << 16 | uint(src[1]) << 8 | uint(src[2])
+        buf[0] = st[v >> 18 & 0x3f]
+        buf[1] = st[v >> 12 & 0x3f]
+        buf[2] = st[v >> 6 & 0x3f]
+        buf[3] = st[v & 0x3f]
+        src = src[3:]
+        buf = buf[4:]
+    }
+
+    /* encode the last few bytes */
+    switch len(src) {
+        case 2: {
+            v := uint(src[0]) << 16 | uint(src[1]) << 8
+            buf[0] = st[v >> 18 & 0x3f]
+            buf[1] = st[v >> 12 & 0x3f]
+            buf[2] = st[v >> 6 & 0x3f]
+            if (self & _MODE_RAW) == 0 {
+                buf[3] = '='
+            }
+        }
+        case 1: {
+            v := uint(src[0]) << 16
+            buf[0] = st[v >> 18 & 0x3f]
+            buf[1] = st[v >> 12 & 0x3f]
+            if (self & _MODE_RAW) == 0 {
+                buf[2] = '='
+                buf[3] = '='
+            }
+        }
+    }
+
+    /* update the output length */
+    setLen(out, nb + ne)
+}
+
+// decodeSmall is the Go version of the native decoder for well-formed
+// inputs. It returns false without touching out for anything else, such
+// as invalid characters, new lines and JSON escapes, which are left to
+// the native decoder, so the error offsets stay the same.
+func (self Encoding) decodeSmall(out *[]byte, src []byte) (int, bool) {
+    ns := len(src)
+    if ns > _SMALL_DECODE_MAX {
+        return 0, false
+    }
+
+    /* select the alphabet */
+    st := self.decodeTable(src)
+
+    /* strip the paddings, they must complete the last group */
+    if (self & _MODE_RAW) == 0 {
+        if ns % 4 != 0 {
+            return 0, false
+        } else if ns != 0 && src[ns - 1] == '=' {
+            if ns--; src[ns - 1] == '=' {
+                ns--
+            }
+        }
+    }
+
+    /* a single character can not make a byte */
+    if ns % 4 == 1 {
+        return 0, false
+    }
+
+    /* decode into a temporary buffer first, so nothing is stored if the
+     * input turns out to be invalid, even if out overlaps with src */
+    op := 0
+    ip := 0
+    buf := [_SMALL_DECODE_MAX / 4 * 3]byte{}
+    for ; ip + 4 <= ns; ip += 4 {
+        c0 := st[src[ip + 0]]
+        c1 := st[src[ip + 1]]
+        c2 := st[src[ip + 2]]
+        c3 := st[src[ip + 3]]
+
+        /* check for invalid characters */
+        if (c0 | c1 | c2 | c3) == 0xff {
+            return 0, false
+        }
+
+        /* store the result */
+        v := uint(c0) << 18 | uint(c1) << 12 | uint(c2) << 6 | uint(c3)
+        buf[op + 0] = byte(v >> 16)
+        buf[op + 1] = byte(v >> 8)
+        buf[op + 2] = byte(v)
+        op += 3
+    }
+
+    /* decode the last 2 or 3 characters, the remaining bits are ignored */
+    switch ns - ip {
+        case 3: {
+            c0 := st[src[ip + 0]]
+            c1 := st[src[ip + 1]]
+            c2 := st[src[ip + 2]]
+            if (c0 | c1 | c2) == 0xff {
+                return 0, false
+            }
+            v := uint(c0) << 18 | uint(c1) << 12 | uint(c2) << 6
+            buf[op + 0] = byte(v >> 16)
+            buf[op + 1] = byte(v >> 8)
+            op += 2
+        }
+        case 2: {
+            c0 := st[src[ip + 0]]
+            c1 := st[src[ip + 1]]
+            if (c0 | c1) == 0xff {
+                return 0, false
+            }
+            v := uint(c0) << 18 | uint(c1) << 12
+            buf[op] = byte(v >> 16)
+            op++
+        }
+    }
+
+    /* copy to the output */
+    nb := len(*out)
+    copy((*out)[nb:nb + op], buf[:op])
+    setLen(out, nb + op)
+    return op, true
+}
@@ -0,0 +1,171 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `encoding/base64`
+    `math/rand`
+    `testing`
+
+    `github.com/cloudwego/base64x/internal/native`
+)
+
+var smallModes = []Encoding{
+    StdEncoding,
+    URLEncoding,
+    RawStdEncoding,
+    RawURLEncoding,
+    JSONStdEncoding,
+}
+
+func TestEncodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, enc := range smallModes {
+        for n := 0; n <= 16; n++ {
+            for i := 0; i < 100; i++ {
+                src := make([]byte, n)
+                rng.Read(src)
+
+                /* compare with the native encoder */
+                got := []byte("prefix")
+                want := []byte("prefix")
+                got = append(got, make([]byte, enc.EncodedLen(n))...)[:6]
+                want = append(want, make([]byte, enc.EncodedLen(n))...)[:6]
+                enc.encodeSmall(&got, src)
+                native.B64Encode(&want, &src, int(enc) | archFlags)
+                testEqual(t, "encodeSmall(%x) = %q, want %q", src, string(got), string(want))
+            }
+        }
+    }
+}
+
+func TestDecodeSmall(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZaz09+/-_=\r\n\\u0=!"
+    for _, enc := range smallModes {
+        for n := 0; n <= _SMALL_DECODE + 4; n++ {
+            for i := 0; i < 2000; i++ {
+                src := make([]byte, n)
+                for j := range src {
+                    src[j] = alphabet[rng.Intn(len(alphabet))]
+                }
+
+                /* mostly valid inputs */
+                if i % 2 == 0 {
+                    buf := make([]byte, rng.Intn(n / 4 * 3 + 1))
+                    rng.Read(buf)
+                    src = []byte(enc.EncodeToString(buf))
+                }
+
+                /* compare with the native decoder, which accepts a partial
+                 * padding, so DecodedLen may be too small */
+                got := make([]byte, 6, 6 + len(src))
+                want := make([]byte, 6, 6 + len(src))
+                gn, gerr := enc.DecodeUnsafe(&got, src)
+                wn := native.B64Decode(&want, mem2addr(src), len(src), int(enc) | archFlags)
+
+                /* errors are left to the native decoder */
+                if wn < 0 {
+                    testEqual(t, "DecodeUnsafe(%q) = error %v, want %v", src, gerr, error(base64.CorruptInputError(-wn - 1)))
+                    testEqual(t, "DecodeUnsafe(%q) = %d bytes, want %d", src, len(got), 6)
+                } else {
+                    testEqual(t, "DecodeUnsafe(%q) = error %v, want %v", src, gerr, error(nil))
+                    testEqual(t, "DecodeUnsafe(%q) = %d, want %d", src, gn, wn)
+                    testEqual(t, "DecodeUnsafe(%q) = %q, want %q", src, string(got), string(want))
+                }
+            }
+        }
+    }
+}
+
+func TestSmallAllocs(t *testing.T) {
+    src := []byte("Zm9vYg==")
+
+    /* the output buffers on the stack must not escape to the heap */
+    for _, enc := range []Encoding{StdEncoding, RawURLEncoding} {
+        if n := testing.AllocsPerRun(100, func() {
+            var buf [16]byte
+            out := buf[:0]
+            enc.EncodeUnsafe(&out, src[:2])
+            out = buf[:0]
+            _, _ = enc.DecodeUnsafe(&out, src)
+        }); n != 0 {
+            t.Fatalf("%v: %v allocations, want 0", enc, n)
+        }
+    }
+}
+
+func benchmarkEncodeSmall(b *testing.B, n int, asm bool) {
+    src := make([]byte, n)
+    buf := make([]byte, 0, StdEncoding.EncodedLen(n))
+    rand.New(rand.NewSource(0)).Read(src)
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.B64Encode(&out, &src, int(StdEncoding) | archFlags)
+        } else {
+            StdEncoding.encodeSmall(&out, src)
+        }
+    }
+}
+
+func benchmarkDecodeSmall(b *testing.B, n int, asm bool) {
+    raw := make([]byte, n / 4 * 3)
+    buf := make([]byte, 0, len(raw))
+    rand.New(rand.NewSource(0)).Read(raw)
+    src := []byte(StdEncoding.EncodeToString(raw))
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.B64Decode(&out, mem2addr(src), len(src), int(StdEncoding) | archFlags)
+        } else {
+            StdEncoding.decodeSmall(&out, src)
+        }
+    }
+}
+
+func BenchmarkEncodeSmallGo_1B      (b *testing.B) { benchmarkEncodeSmall(b, 1, false) }
+func BenchmarkEncodeSmallGo_2B      (b *testing.B) { benchmarkEncodeSmall(b, 2, false) }
+func BenchmarkEncodeSmallGo_3B      (b *testing.B) { benchmarkEncodeSmall(b, 3, false) }
+func BenchmarkEncodeSmallGo_6B      (b *testing.B) { benchmarkEncodeSmall(b, 6, false) }
+func BenchmarkEncodeSmallGo_16B     (b *testing.B) { benchmarkEncodeSmall(b, 16, false) }
+func BenchmarkEncodeSmallGo_20B     (b *testing.B) { benchmarkEncodeSmall(b, 20, false) }
+func BenchmarkEncodeSmallGo_24B     (b *testing.B) { benchmarkEncodeSmall(b, 24, false) }
+func BenchmarkEncodeSmallNative_1B  (b *testing.B) { benchmarkEncodeSmall(b, 1, true) }
+func BenchmarkEncodeSmallNative_2B  (b *testing.B) { benchmarkEncodeSmall(b, 2, true) }
+func BenchmarkEncodeSmallNative_3B  (b *testing.B) { benchmarkEncodeSmall(b, 3, true) }
+func BenchmarkEncodeSmallNative_6B  (b *testing.B) { benchmarkEncodeSmall(b, 6, true) }
+func BenchmarkEncodeSmallNative_16B (b *testing.B) { benchmarkEncodeSmall(b, 16, true) }
+func BenchmarkEncodeSmallNative_20B (b *testing.B) { benchmarkEncodeSmall(b, 20, true) }
+func BenchmarkEncodeSmallNative_24B (b *testing.B) { benchmarkEncodeSmall(b, 24, true) }
+
+func BenchmarkDecodeSmallGo_4B      (b *testing.B) { benchmarkDecodeSmall(b, 4, false) }
+func BenchmarkDecodeSmallGo_8B      (b *testing.B) { benchmarkDecodeSmall(b, 8, false) }
+func BenchmarkDecodeSmallGo_12B     (b *testing.B) { benchmarkDecodeSmall(b, 12, false) }
+func BenchmarkDecodeSmallGo_16B     (b *testing.B) { benchmarkDecodeSmall(b, 16, false) }
+func BenchmarkDecodeSmallGo_20B     (b *testing.B) { benchmarkDecodeSmall(b, 20, false) }
+func BenchmarkDecodeSmallGo_24B     (b *testing.B) { benchmarkDecodeSmall(b, 24, false) }
+func BenchmarkDecodeSmallNative_4B  (b *testing.B) { benchmarkDecodeSmall(b, 4, true) }
+func BenchmarkDecodeSmallNative_8B  (b *testing.B) { benchmarkDecodeSmall(b, 8, true) }
+func BenchmarkDecodeSmallNative_12B (b *testing.B) { benchmarkDecodeSmall(b, 12, true) }
+func BenchmarkDecodeSmallNative_16B (b *testing.B) { benchmarkDecodeSmall(b, 16, true) }
+func BenchmarkDecodeSmallNative_20B (b *testing.B) { benchmarkDecodeSmall(b, 20, true) }
+func BenchmarkDecodeSmallNative_24B (b *testing.B) { benchmarkDecodeSmall(b, 24, true) }
@@ -0,0 +1,80 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `database/sql/driver`
+    `fmt`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// A Column is binary data stored as base64 text in a database column.
+// It implements sql.Scanner and driver.Valuer, with a nil Data being
+// SQL NULL.
+type Column struct {
+    Data     []byte   // the decoded data
+    Encoding Encoding // the encoding of the column, StdEncoding if zero
+}
+
+// Scan implements sql.Scanner. It decodes a string or []byte source
+// value into Data, reusing its buffer if it is large enough, and sets
+// Data to nil for NULL. Data is also set to nil if the source value is
+// not valid base64 data.
+func (self *Column) Scan(src interface{}) error {
+    var buf []byte
+    var err error
+
+    /* decode the text */
+    switch v := src.(type) {
+        case nil    : buf = nil
+        case []byte : buf, err = self.decode(v)
+        case string : buf, err = self.decode(rt.Str2Mem(v))
+        default     : return fmt.Errorf("base64x: cannot scan %T into Column", src)
+    }
+
+    /* update the data */
+    self.Data = buf
+    return err
+}
+
+func (self *Column) decode(src []byte) ([]byte, error) {
+    buf := self.Data[:0]
+    enc := self.Encoding
+
+    /* allocate a new buffer if needed, an empty value is not NULL */
+    if nb := enc.DecodedCap(len(src)); buf == nil || cap(buf) < nb {
+        buf = make([]byte, 0, nb)
+    }
+
+    /* decode into the buffer */
+    if _, err := enc.DecodeUnsafe(&buf, src); err != nil {
+        return nil, err
+    } else {
+        return buf, nil
+    }
+}
+
+// Value implements driver.Valuer. It returns the encoded Data as a
+// string, or nil if Data is nil.
+func (self Column) Value() (driver.Value, error) {
+    if self.Data == nil {
+        return nil, nil
+    } else {
+        return self.Encoding.EncodeToString(self.Data), nil
+    }
+}
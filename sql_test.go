@@ -0,0 +1,157 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `database/sql`
+    `database/sql/driver`
+    `encoding/base64`
+    `io`
+    `strings`
+    `testing`
+)
+
+// fakeDriver is an in-memory database with a single table of one
+// column, which supports "INSERT" and "SELECT" only.
+type fakeDriver struct {
+    rows []driver.Value
+}
+
+type fakeConn struct{ db *fakeDriver }
+type fakeStmt struct{ db *fakeDriver; query string }
+type fakeRows struct{ rows []driver.Value }
+
+func (self *fakeDriver) Open(string) (driver.Conn, error)        { return &fakeConn{self}, nil }
+func (self *fakeConn) Prepare(q string) (driver.Stmt, error)    { return &fakeStmt{self.db, q}, nil }
+func (self *fakeConn) Close() error                              { return nil }
+func (self *fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+func (self *fakeStmt) Close() error                              { return nil }
+func (self *fakeStmt) Columns() []string                         { return []string{"data"} }
+func (self *fakeRows) Columns() []string                         { return []string{"data"} }
+func (self *fakeRows) Close() error                              { return nil }
+
+func (self *fakeStmt) NumInput() int {
+    return strings.Count(self.query, "?")
+}
+
+func (self *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+    self.db.rows = append(self.db.rows, args...)
+    return driver.RowsAffected(len(args)), nil
+}
+
+func (self *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+    return &fakeRows{self.db.rows}, nil
+}
+
+func (self *fakeRows) Next(dest []driver.Value) error {
+    if len(self.rows) == 0 {
+        return io.EOF
+    }
+    dest[0] = self.rows[0]
+    self.rows = self.rows[1:]
+    return nil
+}
+
+func openFake(t *testing.T, name string) (*fakeDriver, *sql.DB) {
+    drv := new(fakeDriver)
+    sql.Register(name, drv)
+    db, err := sql.Open(name, "")
+    if err != nil {
+        t.Fatal(err)
+    }
+    return drv, db
+}
+
+func TestColumnValue(t *testing.T) {
+    drv, db := openFake(t, "base64x-fake-value")
+    defer db.Close()
+
+    /* every encoding, and NULL */
+    for _, v := range []Column{
+        {Data: []byte("\xfb\xff\xbf?")},
+        {Data: []byte("\xfb\xff\xbf?"), Encoding: RawURLEncoding},
+        {Data: []byte{}},
+        {},
+    } {
+        if _, err := db.Exec("INSERT ?", v); err != nil {
+            t.Fatal(err)
+        }
+    }
+
+    /* stored as base64 text */
+    want := []driver.Value{"+/+/Pw==", "-_-_Pw", "", nil}
+    for i, v := range drv.rows {
+        testEqual(t, "rows[%d] = %#v, want %#v", i, v, want[i])
+    }
+}
+
+func TestColumnScan(t *testing.T) {
+    drv, db := openFake(t, "base64x-fake-scan")
+    defer db.Close()
+    drv.rows = []driver.Value{"Zm9vYmFy", []byte("Zm9v"), nil, "", "_w"}
+
+    /* scan all rows into the same column */
+    rows, err := db.Query("SELECT")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer rows.Close()
+
+    /* the buffer is reused */
+    var v Column
+    var got []string
+    var addr []*byte
+    for rows.Next() {
+        if v.Encoding = StdEncoding; len(got) == 4 {
+            v.Encoding = RawURLEncoding
+        }
+        if err = rows.Scan(&v); err != nil {
+            t.Fatal(err)
+        }
+        if got = append(got, string(v.Data)); v.Data != nil && cap(v.Data) != 0 {
+            addr = append(addr, &v.Data[:1][0])
+        } else {
+            addr = append(addr, nil)
+        }
+        testEqual(t, "Scan() = NULL %v, want %v", v.Data == nil, len(got) == 3)
+    }
+
+    /* check the result */
+    testEqual(t, "Scan() = %q, want %q", strings.Join(got, ","), "foobar,foo,,,\xff")
+    testEqual(t, "Scan() = buffer %p, want %p", addr[1], addr[0])
+    testEqual(t, "Scan() = error %v, want %v", rows.Err(), error(nil))
+}
+
+func TestColumnScanShortPadding(t *testing.T) {
+    buf := []byte("xxx*****")
+    v := Column{Data: buf[:0:3]}
+    err := v.Scan("Zm9vZg=")
+    testEqual(t, "Scan() = error %v, want %v", err, error(nil))
+    testEqual(t, "Scan() = %q, want %q", string(v.Data), "foof")
+    testEqual(t, "Scan() = %q beyond the buffer, want %q", string(buf[3:]), "*****")
+}
+
+func TestColumnScanError(t *testing.T) {
+    v := Column{Data: []byte("foo")}
+    err := v.Scan("Zm9v!A==")
+    testEqual(t, "Scan() = error %v, want %v", err, error(base64.CorruptInputError(4)))
+    testEqual(t, "Scan() = NULL %v, want %v", v.Data == nil, true)
+
+    /* unsupported source types */
+    err = v.Scan(int64(1))
+    testEqual(t, "Scan() = error %v, want %v", err.Error(), "base64x: cannot scan int64 into Column")
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Package thriftjson implements the binary values of the Apache Thrift
+// JSON protocol (TJSONProtocol), which are JSON strings holding the
+// base64 encoding of the data, with base64x.JSONStdEncoding.
+package thriftjson
+
+import (
+    `encoding/base64`
+    `errors`
+
+    `github.com/cloudwego/base64x`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+const (
+    _JSON_QUOTE = '"'
+    _JSON_NULL  = "null"
+)
+
+// ErrString is returned by ReadBinary for values that are neither a
+// JSON string nor null, or strings that are not terminated.
+var ErrString = errors.New("thriftjson: binary value is not a JSON string")
+
+// ReadBinary reads the binary value at the beginning of src, after any
+// JSON whitespace, and returns the decoded data and the number of bytes
+// of src that were read. It returns a nil slice for null.
+//
+// The string may contain JSON escapes, and the paddings are optional:
+// like Apache Thrift, which pads the string to a multiple of 4 before
+// decoding it, ReadBinary accepts "Zg", "Zg=" and "Zg==". The offset in
+// a base64.CorruptInputError is relative to the start of the string
+// contents, as it is in Apache Thrift.
+func ReadBinary(src []byte) ([]byte, int, error) {
+    i := skipSpaces(src)
+
+    /* null is a nil value */
+    if len(src) - i >= len(_JSON_NULL) && string(src[i:i + len(_JSON_NULL)]) == _JSON_NULL {
+        return nil, i + len(_JSON_NULL), nil
+    }
+
+    /* find the string contents */
+    if i == len(src) || src[i] != _JSON_QUOTE {
+        return nil, 0, ErrString
+    }
+    ie := indexQuote(src, i + 1)
+    if ie < 0 {
+        return nil, 0, ErrString
+    }
+
+    /* strip the trailing paddings, they are checked below */
+    body := src[i + 1:ie]
+    nb := len(body)
+    for nb > 0 && body[nb - 1] == '=' {
+        nb--
+    }
+
+    /* decode the characters, JSON escapes included */
+    enc := base64x.JSONStdEncoding.OptionalPadding()
+    ret := make([]byte, 0, enc.DecodedLen(nb))
+    if _, err := enc.DecodeUnsafe(&ret, body[:nb]); err != nil {
+        return nil, 0, err
+    }
+
+    /* the paddings must not exceed the last group */
+    if np := len(body) - nb; np > 0 {
+        if pad := padLen(len(ret)); np > pad {
+            return nil, 0, base64.CorruptInputError(nb + pad)
+        }
+    }
+    return ret, ie + 1, nil
+}
+
+// AppendBinary appends the binary value of v to dst, as written by
+// Apache Thrift, and returns the extended buffer.
+func AppendBinary(dst []byte, v []byte) []byte {
+    dst = rt.GrowSlice(dst, BinaryLen(len(v)))
+    dst = append(dst, _JSON_QUOTE)
+    base64x.JSONStdEncoding.EncodeUnsafe(&dst, v)
+    return append(dst, _JSON_QUOTE)
+}
+
+// BinaryLen returns the length of the binary value of n bytes of data.
+func BinaryLen(n int) int {
+    return base64x.JSONStdEncoding.EncodedLen(n) + 2
+}
+
+// padLen returns the number of paddings that complete the last group of
+// the encoding of n bytes.
+func padLen(n int) int {
+    switch n % 3 {
+        case 1  : return 2
+        case 2  : return 1
+        default : return 0
+    }
+}
+
+// indexQuote returns the index of the closing quote of the string that
+// starts at src[i], skipping the escaped characters, or -1.
+func indexQuote(src []byte, i int) int {
+    for ; i < len(src); i++ {
+        switch src[i] {
+            case '\\'        : i++
+            case _JSON_QUOTE : return i
+        }
+    }
+    return -1
+}
+
+// skipSpaces returns the index of the first non-whitespace character.
+func skipSpaces(src []byte) int {
+    i := 0
+    for i < len(src) && (src[i] == ' ' || src[i] == '\t' || src[i] == '\r' || src[i] == '\n') {
+        i++
+    }
+    return i
+}
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package thriftjson
+
+import (
+    `encoding/base64`
+    `testing`
+)
+
+// thriftVectors are written by TJSONProtocol.WriteBinary of the Apache
+// Thrift Go library, v0.21.0.
+var thriftVectors = []struct {
+    data string
+    json string
+}{
+    {"", `""`},
+    {"f", `"Zg=="`},
+    {"fo", `"Zm8="`},
+    {"foo", `"Zm9v"`},
+    {"foob", `"Zm9vYg=="`},
+    {"fooba", `"Zm9vYmE="`},
+    {"foobar", `"Zm9vYmFy"`},
+    {"\x00\xff\xfe\xfb\xef", `"AP/+++8="`},
+    {
+        "\x00\x01\x02\x03\x04\x05\x06\a\b\t\n\v\f\r\x0e\x0f\x10\x11\x12\x13\x14\x15\x16\x17\x18\x19\x1a\x1b\x1c\x1d\x1e\x1f !\"#$%&'()*+,-./0123456789:;<=>?",
+        `"AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8gISIjJCUmJygpKissLS4vMDEyMzQ1Njc4OTo7PD0+Pw=="`,
+    },
+}
+
+// thriftReads are read by TJSONProtocol.ReadBinary of the Apache Thrift
+// Go library, v0.21.0, with the errors at the same offsets.
+var thriftReads = []struct {
+    json string
+    data string
+    pos  int
+}{
+    {`"Zg=="`, "f", -1},
+    {`"Zg="`, "f", -1},
+    {`"Zg"`, "f", -1},
+    {`"Zm9="`, "fo", -1},
+    {`"Zm9vYg"`, "foob", -1},
+    {`"Zm9vYg="`, "foob", -1},
+    {`""`, "", -1},
+    {`"Z"`, "", 1},
+    {`"Zm9v="`, "", 4},
+    {`"Zm9=="`, "", 4},
+    {`"Zg==="`, "", 4},
+    {`"Zm!v"`, "", 2},
+    {`"-_8"`, "", 0},
+}
+
+func TestWriteBinary(t *testing.T) {
+    for _, tt := range thriftVectors {
+        buf := AppendBinary([]byte("["), []byte(tt.data))
+        if string(buf) != "[" + tt.json {
+            t.Fatalf("AppendBinary(%q) = %s, want [%s", tt.data, buf, tt.json)
+        }
+        if n := BinaryLen(len(tt.data)); n != len(tt.json) {
+            t.Fatalf("BinaryLen(%d) = %d, want %d", len(tt.data), n, len(tt.json))
+        }
+    }
+}
+
+func TestReadBinary(t *testing.T) {
+    for _, tt := range thriftVectors {
+        buf, n, err := ReadBinary([]byte(tt.json + ",1"))
+        if err != nil || n != len(tt.json) || string(buf) != tt.data || buf == nil {
+            t.Fatalf("ReadBinary(%s) = %q, %d, %v, want %q, %d", tt.json, buf, n, err, tt.data, len(tt.json))
+        }
+    }
+}
+
+func TestReadBinaryThrift(t *testing.T) {
+    for _, tt := range thriftReads {
+        var want error
+        if tt.pos >= 0 {
+            want = base64.CorruptInputError(tt.pos)
+        }
+        buf, _, err := ReadBinary([]byte(tt.json))
+        if err != want || string(buf) != tt.data {
+            t.Fatalf("ReadBinary(%s) = %q, %v, want %q, %v", tt.json, buf, err, tt.data, want)
+        }
+    }
+}
+
+func TestReadBinaryJSON(t *testing.T) {
+    for _, tt := range []struct {
+        json string
+        data string
+        n    int
+    }{
+        {`"Z\/8="`, "g\xff", 7},
+        {`"Zm9v\nYg=="`, "foob", 12},
+        {`"Zm9v\u0059g"`, "foob", 13},
+        {" \n\t\"Zg==\"", "f", 9},
+    } {
+        buf, n, err := ReadBinary([]byte(tt.json))
+        if err != nil || n != tt.n || string(buf) != tt.data {
+            t.Fatalf("ReadBinary(%s) = %q, %d, %v, want %q, %d", tt.json, buf, n, err, tt.data, tt.n)
+        }
+    }
+
+    /* null is a nil value */
+    buf, n, err := ReadBinary([]byte(" null]"))
+    if err != nil || n != 5 || buf != nil {
+        t.Fatalf("ReadBinary(null) = %q, %d, %v, want nil, 5", buf, n, err)
+    }
+
+    /* not a string */
+    for _, src := range []string{``, `Zg==`, `"Zg==`, `"Zg==\"`, `nul`, `1`} {
+        if _, _, err := ReadBinary([]byte(src)); err != ErrString {
+            t.Fatalf("ReadBinary(%s) = error %v, want %v", src, err, ErrString)
+        }
+    }
+}
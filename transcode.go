@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// Inputs up to this size are transcoded in Go, longer ones in native
+// code. The threshold is chosen by BenchmarkTranscodeSmall, which
+// compares the Go version with the native call alone.
+const (
+    _SMALL_TRANSCODE = 31
+)
+
+// Transcode appends src, which is encoded with from, to dst re-encoded
+// with to, and returns the extended buffer. The output is the same as
+// decoding src with from and encoding the result with to, and so are
+// the errors.
+//
+// Well-formed inputs are transcoded in a single pass, which maps the
+// alphabets and fixes the paddings without decoding the data. Other
+// inputs are decoded into the spare capacity of dst, and then encoded
+// in place, so no temporary buffer is allocated.
+func Transcode(dst []byte, from Encoding, to Encoding, src []byte) ([]byte, error) {
+    if len(src) <= _SMALL_TRANSCODE {
+        if ret, ok := transcodeFast(dst, from, to, src); ok {
+            return ret, nil
+        }
+    }
+
+    /* the output is large enough for the decoded data too */
+    nb := len(dst)
+    ret := rt.GrowSlice(dst, to.EncodedLen(from.DecodedCap(len(src))))
+    buf := ret[nb:nb:cap(ret)]
+
+    /* transcode in native code */
+    if len(src) > _SMALL_TRANSCODE && native.B64Transcode(&buf, mem2addr(src), len(src), int(from), int(to)) >= 0 {
+        return ret[:nb + len(buf)], nil
+    }
+
+    /* otherwise decode into the output, and encode in place */
+    if n, err := from.DecodeUnsafe(&buf, src); err != nil {
+        return dst, err
+    } else {
+        return ret[:nb + len(to.EncodeInPlace(buf, n))], nil
+    }
+}
+
+// transcodeFast is the Go version of the native transcoder. It returns
+// false for anything but well-formed inputs.
+func transcodeFast(dst []byte, from Encoding, to Encoding, src []byte) ([]byte, bool) {
+    ns := len(src)
+    st := &decodeTableStd
+    dt := charsetStd
+
+    /* select the alphabets */
+    if (from & _MODE_URL) != 0 {
+        st = &decodeTableURL
+    }
+    if (to & _MODE_URL) != 0 {
+        dt = charsetURL
+    }
+
+    /* strip the paddings, they must complete the last group */
+    if (from & _MODE_RAW) == 0 {
+        if ns % 4 != 0 {
+            return dst, false
+        } else if ns != 0 && src[ns - 1] == '=' {
+            if ns--; src[ns - 1] == '=' {
+                ns--
+            }
+        }
+    }
+
+    /* a single character can not make a byte */
+    if ns % 4 == 1 {
+        return dst, false
+    }
+
+    /* the output length */
+    nb := len(dst)
+    nd := ns
+    if (to & _MODE_RAW) == 0 {
+        nd = (ns + 3) / 4 * 4
+    }
+
+    /* map every character */
+    ret := rt.GrowSlice(dst, nd)[:nb + nd]
+    buf := ret[nb:]
+    for i := 0; i < ns; i++ {
+        if v := st[src[i]]; v == 0xff {
+            return dst, false
+        } else {
+            buf[i] = dt[v]
+        }
+    }
+
+    /* clear the unused bits of the last character, as the encoder does */
+    switch ns % 4 {
+        case 2: buf[ns - 1] = dt[st[src[ns - 1]] & 0x30]
+        case 3: buf[ns - 1] = dt[st[src[ns - 1]] & 0x3c]
+    }
+
+    /* add the paddings */
+    for i := ns; i < nd; i++ {
+        buf[i] = '='
+    }
+    return ret, true
+}
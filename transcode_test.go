@@ -0,0 +1,177 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package base64x
+
+import (
+    `math/rand`
+    `testing`
+    `unsafe`
+
+    `github.com/cloudwego/base64x/internal/native`
+    `github.com/cloudwego/base64x/internal/native/sse`
+)
+
+func TestTranscode(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 100; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+        for _, from := range smallModes {
+            for _, to := range smallModes {
+                enc := []byte(from.EncodeToString(src))
+                got, err := Transcode([]byte("prefix"), from, to, enc)
+                testEqual(t, "Transcode(%q) = error %v, want %v", enc, err, error(nil))
+                testEqual(t, "Transcode(%q) = %q, want %q", enc, string(got), "prefix" + to.EncodeToString(src))
+            }
+        }
+    }
+}
+
+func TestTranscodeDecoder(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZaz09+/-_=\r\n\\u0=!"
+    for _, from := range smallModes {
+        for n := 0; n < 20; n++ {
+            for i := 0; i < 1000; i++ {
+                src := make([]byte, n)
+                for j := range src {
+                    src[j] = alphabet[rng.Intn(len(alphabet))]
+                }
+
+                /* compare with decoding and encoding */
+                buf := make([]byte, 0, len(src))
+                _, want := from.DecodeUnsafe(&buf, src)
+                got, err := Transcode(nil, from, RawURLEncoding, src)
+                testEqual(t, "Transcode(%q) = error %v, want %v", src, err, want)
+                if want == nil {
+                    testEqual(t, "Transcode(%q) = %q, want %q", src, string(got), RawURLEncoding.EncodeToString(buf))
+                }
+            }
+        }
+    }
+}
+
+func TestTranscodeLong(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 20; n < 60; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* with a partial padding accepted by the decoder */
+        enc := StdEncoding.EncodeToString(src)
+        for _, in := range []string{enc, enc + "Zg="} {
+            buf := make([]byte, 0, len(in))
+            _, want := StdEncoding.DecodeUnsafe(&buf, []byte(in))
+            got, err := Transcode([]byte("prefix"), StdEncoding, URLEncoding, []byte(in))
+            testEqual(t, "Transcode(%q) = error %v, want %v", in, err, want)
+            if want == nil {
+                testEqual(t, "Transcode(%q) = %q, want %q", in, string(got), "prefix" + URLEncoding.EncodeToString(buf))
+            } else {
+                testEqual(t, "Transcode(%q) = %q, want %q", in, string(got), "prefix")
+            }
+        }
+    }
+
+    /* nothing is allocated with enough room in dst */
+    src := []byte(StdEncoding.EncodeToString(make([]byte, 300)))
+    dst := make([]byte, 0, 512)
+    if n := testing.AllocsPerRun(100, func() { _, _ = Transcode(dst, StdEncoding, RawURLEncoding, src) }); n != 0 {
+        t.Fatalf("Transcode(): %v allocations, want 0", n)
+    }
+}
+
+func TestTranscodeNative(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    alphabet := "AZaz09+/-_=\r\n\\!"
+    if sse.S_b64transcode == 0 {
+        sse.Use()
+    }
+
+    /* the native transcoders accept what transcodeFast accepts */
+    for _, from := range smallModes {
+        for _, to := range smallModes {
+            for n := 0; n <= 160; n++ {
+                buf := make([]byte, n)
+                rng.Read(buf)
+                src := []byte(from.EncodeToString(buf))
+                for j := rng.Intn(4); j > 0 && len(src) != 0 && n % 2 == 0; j-- {
+                    src[rng.Intn(len(src))] = alphabet[rng.Intn(len(alphabet))]
+                }
+
+                /* compare with the Go version */
+                want, ok := transcodeFast([]byte("prefix"), from, to, src)
+                for _, fn := range []func(*[]byte, unsafe.Pointer, int, int, int) int{native.B64Transcode, sse.B64transcode} {
+                    got := make([]byte, 6, 6 + to.EncodedLen(from.DecodedCap(len(src))))
+                    copy(got, "prefix")
+                    if rv := fn(&got, mem2addr(src), len(src), int(from), int(to)); !ok {
+                        testEqual(t, "B64Transcode(%q) = %d, want %d", src, rv, -1)
+                    } else {
+                        testEqual(t, "B64Transcode(%q) = %d, want %d", src, rv, len(want) - 6)
+                        testEqual(t, "B64Transcode(%q) = %q, want %q", src, string(got), string(want))
+                    }
+                }
+            }
+        }
+    }
+}
+
+func TestTranscodeExamples(t *testing.T) {
+    for _, tt := range []struct {
+        from Encoding
+        to   Encoding
+        src  string
+        dst  string
+    }{
+        {StdEncoding, RawURLEncoding, "+/+/Pw==", "-_-_Pw"},
+        {RawURLEncoding, StdEncoding, "-_-_Pw", "+/+/Pw=="},
+        {RawURLEncoding, StdEncoding, "-_-_Px", "+/+/Pw=="},
+        {StdEncoding, URLEncoding, "Zm9v\r\nYmFy", "Zm9vYmFy"},
+        {JSONStdEncoding, RawStdEncoding, `Zm9v\/w==`, "Zm9v/w"},
+    } {
+        got, err := Transcode(nil, tt.from, tt.to, []byte(tt.src))
+        testEqual(t, "Transcode(%q) = error %v, want %v", tt.src, err, error(nil))
+        testEqual(t, "Transcode(%q) = %q, want %q", tt.src, string(got), tt.dst)
+    }
+}
+
+func benchmarkTranscodeSmall(b *testing.B, n int, asm bool) {
+    raw := make([]byte, n / 4 * 3)
+    buf := make([]byte, 0, n)
+    rand.New(rand.NewSource(0)).Read(raw)
+    src := []byte(StdEncoding.EncodeToString(raw))
+    b.SetBytes(int64(n))
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        out := buf[:0]
+        if asm {
+            native.B64Transcode(&out, mem2addr(src), len(src), int(StdEncoding), int(RawURLEncoding))
+        } else {
+            transcodeFast(out, StdEncoding, RawURLEncoding, src)
+        }
+    }
+}
+
+func BenchmarkTranscodeSmallGo_8B       (b *testing.B) { benchmarkTranscodeSmall(b, 8, false) }
+func BenchmarkTranscodeSmallGo_16B      (b *testing.B) { benchmarkTranscodeSmall(b, 16, false) }
+func BenchmarkTranscodeSmallGo_24B      (b *testing.B) { benchmarkTranscodeSmall(b, 24, false) }
+func BenchmarkTranscodeSmallGo_32B      (b *testing.B) { benchmarkTranscodeSmall(b, 32, false) }
+func BenchmarkTranscodeSmallGo_64B      (b *testing.B) { benchmarkTranscodeSmall(b, 64, false) }
+func BenchmarkTranscodeSmallNative_8B   (b *testing.B) { benchmarkTranscodeSmall(b, 8, true) }
+func BenchmarkTranscodeSmallNative_16B  (b *testing.B) { benchmarkTranscodeSmall(b, 16, true) }
+func BenchmarkTranscodeSmallNative_24B  (b *testing.B) { benchmarkTranscodeSmall(b, 24, true) }
+func BenchmarkTranscodeSmallNative_32B  (b *testing.B) { benchmarkTranscodeSmall(b, 32, true) }
+func BenchmarkTranscodeSmallNative_64B  (b *testing.B) { benchmarkTranscodeSmall(b, 64, true) }
@@ -0,0 +1,173 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uuencode
+
+import (
+    `bytes`
+    `errors`
+    `io`
+    `os`
+    `strconv`
+    `strings`
+)
+
+var (
+    // ErrHeader is returned when a document has no valid "begin" line,
+    // or when a Header cannot be written as one.
+    ErrHeader = errors.New("uuencode: missing or invalid begin line")
+
+    // ErrTrailer is returned when the body of a document is not
+    // followed by the "end" line.
+    ErrTrailer = errors.New("uuencode: missing end line")
+)
+
+const (
+    _DEFAULT_MODE = 0644
+)
+
+// A Header is the "begin" line of a uuencoded document.
+type Header struct {
+    Name string      // the file name, which must not contain line breaks
+    Mode os.FileMode // the permission bits, 0644 is written if zero
+}
+
+// appendHeader appends the "begin" line of hdr to out.
+func appendHeader(out []byte, hdr *Header) ([]byte, error) {
+    mode := hdr.Mode.Perm()
+    if mode == 0 {
+        mode = _DEFAULT_MODE
+    }
+
+    /* the name must fit on the line */
+    if hdr.Name == "" || strings.ContainsAny(hdr.Name, "\r\n") {
+        return out, ErrHeader
+    }
+
+    /* "begin <mode> <name>" */
+    out = append(out, "begin "...)
+    out = append(out, "000"[len(strconv.FormatUint(uint64(mode), 8)):]...)
+    out = strconv.AppendUint(out, uint64(mode), 8)
+    out = append(out, ' ')
+    out = append(out, hdr.Name...)
+    return append(out, '\n'), nil
+}
+
+// parseHeader parses a "begin" line without the line break. It returns
+// false if line is not a "begin" line.
+func parseHeader(hdr *Header, line []byte) bool {
+    var ok bool
+    var mode, name string
+
+    /* "begin <mode> <name>" */
+    if !bytes.HasPrefix(line, []byte("begin ")) {
+        return false
+    } else if mode, name, ok = cut(string(line[6:]), ' '); !ok || name == "" {
+        return false
+    }
+
+    /* the mode is in octal */
+    if v, err := strconv.ParseUint(mode, 8, 32); err != nil {
+        return false
+    } else {
+        hdr.Name = name
+        hdr.Mode = os.FileMode(v).Perm()
+        return true
+    }
+}
+
+func cut(s string, sep byte) (string, string, bool) {
+    if i := strings.IndexByte(s, sep); i < 0 {
+        return s, "", false
+    } else {
+        return s[:i], s[i + 1:], true
+    }
+}
+
+func isTrailer(line []byte) bool {
+    return string(bytes.TrimRight(line, " \t")) == "end"
+}
+
+// EncodeFile returns the uuencoded document of data, which consists of
+// the "begin" line of hdr, the body lines and the "end" line.
+func (self Encoding) EncodeFile(hdr Header, data []byte) ([]byte, error) {
+    ret := make([]byte, 0, len(hdr.Name) + self.EncodedLen(len(data)) + 16)
+    ret, err := appendHeader(ret, &hdr)
+
+    /* check for header errors */
+    if err != nil {
+        return nil, err
+    }
+
+    /* the body and the "end" line */
+    self.EncodeUnsafe(&ret, data)
+    return append(ret, "end\n"...), nil
+}
+
+// DecodeFile decodes the first uuencoded document in src, and returns
+// its header and data. Lines before the "begin" line are skipped, so
+// the document may be embedded in a mail message.
+//
+// It returns ErrHeader if there is no "begin" line, CorruptInputError
+// with an offset relative to src for invalid body lines,
+// io.ErrUnexpectedEOF if src ends within the body, and ErrTrailer if
+// the "end" line is missing.
+func (self Encoding) DecodeFile(src []byte) (hdr Header, data []byte, err error) {
+    ip := 0
+
+    /* find the "begin" line */
+    for {
+        if ip == len(src) {
+            return Header{}, nil, ErrHeader
+        }
+        line, next := nextLine(src, ip)
+        if ip = next; parseHeader(&hdr, line) {
+            break
+        }
+    }
+
+    /* decode the body until the terminating line */
+    buf := make([]byte, self.DecodedLen(len(src) - ip))
+    nb := 0
+    for {
+        if ip == len(src) {
+            return Header{}, nil, io.ErrUnexpectedEOF
+        }
+
+        /* decode the line */
+        line, next := nextLine(src, ip)
+        nd, err := self.decodeLine(buf[nb:], line)
+        if err != nil {
+            return Header{}, nil, err.(CorruptInputError) + CorruptInputError(ip)
+        }
+
+        /* move to the next line */
+        ip = next
+        nb += nd
+
+        /* check for the terminating line */
+        if nd == 0 {
+            break
+        }
+    }
+
+    /* followed by the "end" line */
+    if line, _ := nextLine(src, ip); !isTrailer(line) {
+        return Header{}, nil, ErrTrailer
+    } else {
+        return hdr, buf[:nb], nil
+    }
+}
@@ -0,0 +1,294 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uuencode
+
+import (
+    `bufio`
+    `errors`
+    `io`
+)
+
+const (
+    _STREAM_BUF = 4096
+)
+
+var errClosed = errors.New("uuencode: write to closed writer")
+
+// A Writer is an io.WriteCloser. Data written to a Writer is encoded
+// into a uuencoded document, and written to the underlying writer.
+type Writer struct {
+    Header // written before the first body line
+
+    enc  Encoding
+    err  error
+    w    io.Writer
+    hdr  bool
+    buf  [_LINE_LEN]byte
+    nbuf int
+    out  []byte
+}
+
+// NewWriter returns a new Writer, which writes a document encoded
+// using enc to w. The fields of Header must be set before the first
+// call to Write or Close, and the "end" line is only written when the
+// writer is closed.
+func NewWriter(enc Encoding, w io.Writer) *Writer {
+    return &Writer{enc: enc, w: w}
+}
+
+// Write writes the encoded form of p to the underlying writer, one
+// line of 45 bytes at a time. It returns ErrHeader if the Header
+// cannot be written.
+func (self *Writer) Write(p []byte) (n int, err error) {
+    if self.err != nil {
+        return 0, self.err
+    }
+
+    /* write the "begin" line first */
+    if !self.hdr {
+        if self.err = self.flushHeader(); self.err != nil {
+            return 0, self.err
+        }
+    }
+
+    /* complete the buffered line first */
+    if self.nbuf > 0 {
+        i := copy(self.buf[self.nbuf:], p)
+        n += i
+        p = p[i:]
+        if self.nbuf += i; self.nbuf < _LINE_LEN {
+            return
+        }
+        if self.err = self.flush(self.buf[:]); self.err != nil {
+            return n, self.err
+        }
+        self.nbuf = 0
+    }
+
+    /* encode whole lines, as many as the output buffer can hold */
+    for len(p) >= _LINE_LEN {
+        nb := _STREAM_BUF / (_LINE_ENC + 2) * _LINE_LEN
+        if nb > len(p) {
+            nb = len(p)
+        }
+        nb -= nb % _LINE_LEN
+        if self.err = self.flush(p[:nb]); self.err != nil {
+            return n, self.err
+        }
+        n += nb
+        p = p[nb:]
+    }
+
+    /* buffer the remaining bytes */
+    self.nbuf = copy(self.buf[:], p)
+    n += self.nbuf
+    return
+}
+
+// Close writes the last body line, the terminating line and the "end"
+// line. It does not close the underlying writer, and it is an error to
+// call Write after calling Close.
+func (self *Writer) Close() error {
+    if self.err == errClosed {
+        return nil
+    } else if self.err != nil {
+        return self.err
+    }
+
+    /* the "begin" line of an empty document */
+    if !self.hdr {
+        if self.err = self.flushHeader(); self.err != nil {
+            return self.err
+        }
+    }
+
+    /* the last line, the terminating line and the "end" line */
+    buf := self.buffer()
+    self.enc.EncodeUnsafe(&buf, self.buf[:self.nbuf])
+    buf = append(buf, "end\n"...)
+
+    /* all further writes fail */
+    if _, self.err = self.w.Write(buf); self.err == nil {
+        self.err = errClosed
+        return nil
+    } else {
+        return self.err
+    }
+}
+
+func (self *Writer) buffer() []byte {
+    if self.out == nil {
+        self.out = make([]byte, 0, _STREAM_BUF)
+    }
+    return self.out[:0]
+}
+
+func (self *Writer) flushHeader() (err error) {
+    buf := self.buffer()
+    self.hdr = true
+
+    /* encode the "begin" line */
+    if buf, err = appendHeader(buf, &self.Header); err != nil {
+        return
+    }
+
+    /* write it out */
+    _, err = self.w.Write(buf)
+    self.out = buf[:0]
+    return
+}
+
+// flush writes the lines of src, which is a multiple of 45 bytes.
+func (self *Writer) flush(src []byte) error {
+    buf := self.buffer()
+    for ; len(src) != 0; src = src[_LINE_LEN:] {
+        buf = buf[:len(buf) + self.enc.encodeLine(buf[len(buf):cap(buf)], src[:_LINE_LEN])]
+    }
+    _, err := self.w.Write(buf)
+    return err
+}
+
+// A Reader is an io.Reader that decodes the body of a uuencoded
+// document.
+type Reader struct {
+    Header // read by NewReader
+
+    enc  Encoding
+    err  error
+    r    *bufio.Reader
+    off  int64
+    out  []byte
+    buf  [64]byte
+    long []byte
+}
+
+// NewReader returns a new Reader, which decodes the document read from
+// r using enc. Lines before the "begin" line are skipped, and it
+// returns ErrHeader if the input ends before a "begin" line.
+//
+// Reads return CorruptInputError with an offset relative to the
+// beginning of r for invalid body lines, io.ErrUnexpectedEOF if the
+// input ends within the body, ErrTrailer if the "end" line is missing,
+// and io.EOF after the "end" line.
+func NewReader(enc Encoding, r io.Reader) (*Reader, error) {
+    ret := &Reader{
+        enc : enc,
+        r   : bufio.NewReaderSize(r, _STREAM_BUF),
+    }
+
+    /* find the "begin" line */
+    for {
+        line, err := ret.readLine()
+        if err == io.EOF {
+            return nil, ErrHeader
+        } else if err != nil {
+            return nil, err
+        }
+        if parseHeader(&ret.Header, line) {
+            return ret, nil
+        }
+    }
+}
+
+// readLine reads the next line without the line break, and io.EOF only
+// if there is no data at all. Lines longer than the buffer are
+// truncated, which is harmless since characters after the ones
+// announced by the length character are ignored.
+func (self *Reader) readLine() ([]byte, error) {
+    line, err := self.r.ReadSlice('\n')
+    self.off += int64(len(line))
+
+    /* keep the beginning of a long line, and skip the rest */
+    if err == bufio.ErrBufferFull {
+        self.long = append(self.long[:0], line...)
+        for line = self.long; err == bufio.ErrBufferFull; {
+            var buf []byte
+            buf, err = self.r.ReadSlice('\n')
+            self.off += int64(len(buf))
+        }
+    }
+
+    /* the last line may not be terminated */
+    if err == io.EOF && len(line) != 0 {
+        err = nil
+    }
+
+    /* strip the line break */
+    if n := len(line); n != 0 && line[n - 1] == '\n' {
+        line = line[:n - 1]
+    }
+    return trimCR(line), err
+}
+
+// Read reads the decoded data of the body, see NewReader for the errors.
+func (self *Reader) Read(p []byte) (n int, err error) {
+    if len(p) == 0 {
+        return 0, nil
+    }
+
+    /* copy out the pending decoded data, if any */
+    for {
+        if len(self.out) > 0 {
+            n = copy(p, self.out)
+            self.out = self.out[n:]
+            return
+        }
+
+        /* sticky errors */
+        if self.err != nil {
+            return 0, self.err
+        }
+
+        /* read the next line */
+        off := self.off
+        line, err := self.readLine()
+
+        /* the body must not end here */
+        if err == io.EOF {
+            self.err = io.ErrUnexpectedEOF
+            continue
+        }
+
+        /* check for read errors */
+        if err != nil {
+            self.err = err
+            continue
+        }
+
+        /* decode the line */
+        nb, err := self.enc.decodeLine(self.buf[:], line)
+        if err != nil {
+            self.err = err.(CorruptInputError) + CorruptInputError(off)
+            continue
+        }
+
+        /* check for the terminating line */
+        if self.out = self.buf[:nb]; nb == 0 {
+            self.err = self.readTrailer()
+        }
+    }
+}
+
+func (self *Reader) readTrailer() error {
+    if line, err := self.readLine(); err != nil && err != io.EOF {
+        return err
+    } else if !isTrailer(line) {
+        return ErrTrailer
+    } else {
+        return io.EOF
+    }
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uuencode
+
+import (
+    `bytes`
+    `io`
+    `math/rand`
+    `strings`
+    `testing`
+    `testing/iotest`
+)
+
+func TestWriter(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    src := make([]byte, 10000)
+    rng.Read(src)
+
+    /* write in chunks of every size */
+    for _, enc := range []Encoding{StdEncoding, XXEncoding} {
+        for _, n := range []int{0, 1, 45, 100, 10000} {
+            want, _ := enc.EncodeFile(Header{Name: "data.bin", Mode: 0600}, src[:n])
+            for _, bs := range []int{1, 2, 44, 45, 46, 1023, 5000} {
+                buf := new(bytes.Buffer)
+                w := NewWriter(enc, buf)
+                w.Name = "data.bin"
+                w.Mode = 0600
+                for p := src[:n]; len(p) > 0; {
+                    nb := bs
+                    if nb > len(p) {
+                        nb = len(p)
+                    }
+                    if _, err := w.Write(p[:nb]); err != nil {
+                        t.Fatal(err)
+                    }
+                    p = p[nb:]
+                }
+                if err := w.Close(); err != nil {
+                    t.Fatal(err)
+                }
+                testEqual(t, "NewWriter(%d, %d) = %q, want %q", enc, bs, buf.String(), string(want))
+            }
+        }
+    }
+}
+
+func TestWriterError(t *testing.T) {
+    w := NewWriter(StdEncoding, io.Discard)
+    _, err := w.Write([]byte("Cat"))
+    testEqual(t, "Write() = error %v, want %v", err, ErrHeader)
+    testEqual(t, "Close() = error %v, want %v", w.Close(), ErrHeader)
+
+    /* write after close */
+    w = NewWriter(StdEncoding, io.Discard)
+    w.Name = "x"
+    testEqual(t, "Close() = error %v, want %v", w.Close(), error(nil))
+    testEqual(t, "Close() = error %v, want %v", w.Close(), error(nil))
+    if _, err = w.Write([]byte("Cat")); err == nil {
+        t.Errorf("Write() after Close() succeeded")
+    }
+}
+
+func TestReader(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    src := make([]byte, 10000)
+    rng.Read(src)
+
+    /* read in one byte at a time, with CRLF line breaks */
+    for _, enc := range []Encoding{StdEncoding, XXEncoding} {
+        doc, _ := enc.EncodeFile(Header{Name: "data.bin", Mode: 0600}, src)
+        for _, in := range []string{string(doc), "preamble\n" + strings.ReplaceAll(string(doc), "\n", "\r\n")} {
+            r, err := NewReader(enc, iotest.OneByteReader(strings.NewReader(in)))
+            if err != nil {
+                t.Fatal(err)
+            }
+            testEqual(t, "NewReader(%d) = %v, want %v", enc, r.Header, Header{Name: "data.bin", Mode: 0600})
+            dec, err := io.ReadAll(iotest.OneByteReader(r))
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("NewReader(%d) = %v", enc, err)
+            }
+        }
+    }
+}
+
+func TestReaderLongLines(t *testing.T) {
+    in := strings.Repeat("x", 10000) + "\nbegin 644 x\n#0V%T" + strings.Repeat("M", 10000) + "\n`\nend\n"
+    r, err := NewReader(StdEncoding, strings.NewReader(in))
+    testEqual(t, "NewReader() = error %v, want %v", err, error(nil))
+    dec, err := io.ReadAll(r)
+    testEqual(t, "NewReader() = error %v, want %v", err, error(nil))
+    testEqual(t, "NewReader() = %q, want %q", string(dec), "Cat")
+}
+
+func TestReaderError(t *testing.T) {
+    _, err := NewReader(StdEncoding, strings.NewReader("#0V%T\n`\nend\n"))
+    testEqual(t, "NewReader() = error %v, want %v", err, ErrHeader)
+
+    /* errors in the body */
+    for _, tt := range []struct {
+        src string
+        err error
+    }{
+        {"begin 644 x\n#0V%T\n", io.ErrUnexpectedEOF},
+        {"begin 644 x\n#0V%T\n`\n", ErrTrailer},
+        {"begin 644 x\n#0V%T\n#0V\n`\nend\n", CorruptInputError(21)},
+        {"begin 644 x\n" + strings.Repeat("M" + strings.Repeat("`", 60) + "\n", 100) + "#0~%T\n", CorruptInputError(6214)},
+    } {
+        r, err := NewReader(StdEncoding, strings.NewReader(tt.src))
+        if err != nil {
+            t.Fatal(err)
+        }
+        _, err = io.Copy(io.Discard, r)
+        testEqual(t, "NewReader(%q) = error %v, want %v", tt.src, err, tt.err)
+    }
+}
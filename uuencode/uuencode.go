@@ -0,0 +1,353 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package uuencode implements the uuencode and xxencode formats, both
+// the encoding of the body lines, with the same API shape as
+// base64x.Encoding, and the full documents with the "begin" and "end"
+// lines, with streaming variants.
+package uuencode
+
+import (
+    `strconv`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// An Encoding is a uuencode-like encoding/decoding scheme. The data is
+// split into lines of at most 45 bytes, each of which is written as a
+// length character followed by every 3 bytes encoded into 4 characters,
+// and the body ends with a line of length zero.
+type Encoding int
+
+const (
+    _MODE_XX = 1 << 0
+)
+
+// StdEncoding is the uuencode encoding, as produced by uuencode(1).
+// The value zero is encoded as '`', and is also decoded from ' '.
+const StdEncoding Encoding = 0
+
+// XXEncoding is the xxencode encoding, which uses an alphabet of
+// letters, digits, '+' and '-' that survives the EBCDIC gateways.
+const XXEncoding Encoding = _MODE_XX
+
+// CorruptInputError is the error returned for malformed input.
+type CorruptInputError int64
+
+func (e CorruptInputError) Error() string {
+    return "illegal uuencode data at input byte " + strconv.FormatInt(int64(e), 10)
+}
+
+const (
+    _LINE_LEN = 45
+    _LINE_ENC = _LINE_LEN / 3 * 4
+)
+
+const (
+    charsetStd = "`!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_"
+    charsetXX  = "+-0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+var (
+    decodeTableStd = makeDecodeTable(charsetStd, ' ')
+    decodeTableXX  = makeDecodeTable(charsetXX, 0)
+)
+
+func makeDecodeTable(charset string, zero byte) (tab [256]byte) {
+    for i := range tab {
+        tab[i] = 0xff
+    }
+    for i := 0; i < len(charset); i++ {
+        tab[charset[i]] = byte(i)
+    }
+    if zero != 0 {
+        tab[zero] = 0
+    }
+    return
+}
+
+func (self Encoding) charset() string {
+    if (self & _MODE_XX) != 0 {
+        return charsetXX
+    } else {
+        return charsetStd
+    }
+}
+
+func (self Encoding) decodeTable() *[256]byte {
+    if (self & _MODE_XX) != 0 {
+        return &decodeTableXX
+    } else {
+        return &decodeTableStd
+    }
+}
+
+/** Encoder Functions **/
+
+// Encode encodes src using the specified encoding, writing
+// EncodedLen(len(src)) bytes to out. The output consists of the body
+// lines, including the terminating line of length zero, each ended
+// with '\n'.
+//
+// If out is not large enough to contain the encoded result,
+// it will panic.
+func (self Encoding) Encode(out []byte, src []byte) {
+    if buf := out[:0:len(out)]; self.EncodedLen(len(src)) <= len(out) {
+        self.EncodeUnsafe(&buf, src)
+    } else {
+        panic("encoder output buffer is too small")
+    }
+}
+
+// EncodeUnsafe behaves like Encode, except it does NOT check if
+// out is large enough to contain the encoded result.
+//
+// It will also update the length of out.
+func (self Encoding) EncodeUnsafe(out *[]byte, src []byte) {
+    nb := len(*out)
+    st := self.charset()
+    ret := (*out)[:nb + self.EncodedLen(len(src))]
+    buf := ret[nb:]
+
+    /* encode every line */
+    for len(src) > _LINE_LEN {
+        buf = buf[self.encodeLine(buf, src[:_LINE_LEN]):]
+        src = src[_LINE_LEN:]
+    }
+
+    /* the last line, if any, and the terminating line */
+    if len(src) != 0 {
+        buf = buf[self.encodeLine(buf, src):]
+    }
+
+    /* update the output length */
+    buf[0] = st[0]
+    buf[1] = '\n'
+    *out = ret
+}
+
+// encodeLine encodes at most 45 bytes of src into a line, and returns
+// the length of the line.
+func (self Encoding) encodeLine(out []byte, src []byte) int {
+    op := 1
+    st := self.charset()
+    out[0] = st[len(src)]
+
+    /* encode every 3 bytes into 4 characters */
+    for len(src) >= 3 {
+        v := uint(src[0]) << 16 | uint(src[1]) << 8 | uint(src[2])
+        out[op + 0] = st[v >> 18 & 0x3f]
+        out[op + 1] = st[v >> 12 & 0x3f]
+        out[op + 2] = st[v >> 6 & 0x3f]
+        out[op + 3] = st[v & 0x3f]
+        src = src[3:]
+        op += 4
+    }
+
+    /* the last partial group is padded with zeros */
+    if len(src) != 0 {
+        var tmp [3]byte
+        copy(tmp[:], src)
+        v := uint(tmp[0]) << 16 | uint(tmp[1]) << 8 | uint(tmp[2])
+        out[op + 0] = st[v >> 18 & 0x3f]
+        out[op + 1] = st[v >> 12 & 0x3f]
+        out[op + 2] = st[v >> 6 & 0x3f]
+        out[op + 3] = st[v & 0x3f]
+        op += 4
+    }
+
+    /* end of line */
+    out[op] = '\n'
+    return op + 1
+}
+
+// EncodeToString returns the encoded body lines of src.
+func (self Encoding) EncodeToString(src []byte) string {
+    nbs := len(src)
+    ret := make([]byte, 0, self.EncodedLen(nbs))
+
+    /* encode into the allocated buffer */
+    self.EncodeUnsafe(&ret, src)
+    return rt.Mem2Str(ret)
+}
+
+// EncodedLen returns the length in bytes of the encoded body lines
+// of an input buffer of length n.
+func (self Encoding) EncodedLen(n int) int {
+    if nb := n % _LINE_LEN; nb == 0 {
+        return n / _LINE_LEN * (_LINE_ENC + 2) + 2
+    } else {
+        return n / _LINE_LEN * (_LINE_ENC + 2) + (nb + 2) / 3 * 4 + 4
+    }
+}
+
+/** Decoder Functions **/
+
+// Decode decodes the body lines in src using the encoding enc. It
+// writes at most DecodedLen(len(src)) bytes to out and returns the
+// number of bytes written. If src contains invalid data, it will
+// return 0 and CorruptInputError.
+//
+// Lines may end with "\r\n", characters after the ones announced by
+// the length character are ignored, and the terminating line of
+// length zero is optional, but must be the last one if present.
+//
+// If out is not large enough to contain the decoded result,
+// it will panic.
+func (self Encoding) Decode(out []byte, src []byte) (int, error) {
+    if len(src) == 0 {
+        return 0, nil
+    } else if buf := out[:0:len(out)]; self.DecodedLen(len(src)) <= len(out) {
+        return self.DecodeUnsafe(&buf, src)
+    } else {
+        panic("decoder output buffer is too small")
+    }
+}
+
+// DecodeUnsafe behaves like Decode, except it does NOT check if
+// out is large enough to contain the decoded result.
+//
+// It will also update the length of out.
+func (self Encoding) DecodeUnsafe(out *[]byte, src []byte) (int, error) {
+    ip := 0
+    op := 0
+    nb := len(*out)
+    buf := (*out)[nb:cap(*out)]
+
+    /* decode every line */
+    for ip < len(src) {
+        line, next := nextLine(src, ip)
+        nd, err := self.decodeLine(buf[op:], line)
+
+        /* check for errors */
+        if err != nil {
+            return 0, err.(CorruptInputError) + CorruptInputError(ip)
+        }
+
+        /* the terminating line must be the last one */
+        if nd == 0 && next != len(src) {
+            return 0, CorruptInputError(next)
+        }
+
+        /* move to the next line */
+        ip = next
+        op += nd
+    }
+
+    /* update the output length */
+    *out = (*out)[:nb + op]
+    return op, nil
+}
+
+// nextLine returns the line starting at src[i] without the line break,
+// and the offset of the next line.
+func nextLine(src []byte, i int) ([]byte, int) {
+    for p := i; p < len(src); p++ {
+        if src[p] == '\n' {
+            return trimCR(src[i:p]), p + 1
+        }
+    }
+    return trimCR(src[i:]), len(src)
+}
+
+func trimCR(line []byte) []byte {
+    if n := len(line); n != 0 && line[n - 1] == '\r' {
+        return line[:n - 1]
+    } else {
+        return line
+    }
+}
+
+// decodeLine decodes a line without the line break into out, and
+// returns the number of bytes written. The offset of the error is
+// relative to the line.
+func (self Encoding) decodeLine(out []byte, line []byte) (int, error) {
+    st := self.decodeTable()
+
+    /* the length character */
+    if len(line) == 0 {
+        return 0, CorruptInputError(0)
+    }
+
+    /* check for invalid length */
+    nb := int(st[line[0]])
+    if nb == 0xff {
+        return 0, CorruptInputError(0)
+    }
+
+    /* must have enough characters */
+    nc := (nb + 2) / 3 * 4
+    if len(line) - 1 < nc {
+        return 0, CorruptInputError(len(line))
+    }
+
+    /* decode every 4 characters into 3 bytes */
+    op := 0
+    for ip := 1; ip <= nc; ip += 4 {
+        c0 := st[line[ip + 0]]
+        c1 := st[line[ip + 1]]
+        c2 := st[line[ip + 2]]
+        c3 := st[line[ip + 3]]
+
+        /* check for invalid characters */
+        if (c0 | c1 | c2 | c3) == 0xff {
+            return 0, CorruptInputError(ip + invalidAt(c0, c1, c2))
+        }
+
+        /* store the result, the padding bytes are dropped */
+        v := uint(c0) << 18 | uint(c1) << 12 | uint(c2) << 6 | uint(c3)
+        switch nb - op {
+            case 1  : out[op] = byte(v >> 16)
+            case 2  : out[op], out[op + 1] = byte(v >> 16), byte(v >> 8)
+            default : out[op], out[op + 1], out[op + 2] = byte(v >> 16), byte(v >> 8), byte(v)
+        }
+        op += 3
+    }
+
+    /* the last group may be partial */
+    if op > nb {
+        op = nb
+    }
+    return op, nil
+}
+
+func invalidAt(c0 byte, c1 byte, c2 byte) int {
+    switch {
+        case c0 == 0xff : return 0
+        case c1 == 0xff : return 1
+        case c2 == 0xff : return 2
+        default         : return 3
+    }
+}
+
+// DecodeString returns the bytes represented by the body lines in s.
+func (self Encoding) DecodeString(s string) ([]byte, error) {
+    src := rt.Str2Mem(s)
+    ret := make([]byte, 0, self.DecodedLen(len(s)))
+
+    /* decode into the allocated buffer */
+    if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+        return nil, err
+    } else {
+        return ret, nil
+    }
+}
+
+// DecodedLen returns the maximum length in bytes of the decoded data
+// corresponding to n bytes of encoded body lines.
+func (self Encoding) DecodedLen(n int) int {
+    return n / 4 * 3
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package uuencode
+
+import (
+    `bytes`
+    `io`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+type TestPair struct {
+    decoded string
+    encoded string
+}
+
+// produced by Python binascii.b2a_uu(backtick=True)
+var pairs = []TestPair{
+    {"", "`\n"},
+    {"C", "!0P``\n`\n"},
+    {"Ca", "\"0V$`\n`\n"},
+    {"Cat", "#0V%T\n`\n"},
+    {"hello, world", ",:&5L;&\\L('=O<FQD\n`\n"},
+    {strings.Repeat("\x00", 46), "M" + strings.Repeat("`", 60) + "\n!````\n`\n"},
+}
+
+func testEqual(t *testing.T, msg string, args ...interface{}) bool {
+    t.Helper()
+    if args[len(args) - 2] != args[len(args) - 1] {
+        t.Errorf(msg, args...)
+        return false
+    }
+    return true
+}
+
+// xxRef converts the uuencoded lines into xxencoded ones.
+func xxRef(s string) string {
+    buf := []byte(s)
+    for i, c := range buf {
+        if c != '\n' {
+            buf[i] = charsetXX[(c - ' ') & 0x3f]
+        }
+    }
+    return string(buf)
+}
+
+func TestEncoder(t *testing.T) {
+    for _, p := range pairs {
+        got := StdEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, p.encoded)
+        got = XXEncoding.EncodeToString([]byte(p.decoded))
+        testEqual(t, "Encode(%q) = %q, want %q", p.decoded, got, xxRef(p.encoded))
+    }
+    testEqual(t, "Encode(%q) = %q, want %q", "Cat", XXEncoding.EncodeToString([]byte("Cat")), "1Eq3o\n+\n")
+}
+
+func TestDecoder(t *testing.T) {
+    for _, p := range pairs {
+        for _, enc := range []Encoding{StdEncoding, XXEncoding} {
+            src := p.encoded
+            if enc == XXEncoding {
+                src = xxRef(src)
+            }
+
+            /* into a buffer of DecodedLen bytes */
+            dbuf := make([]byte, enc.DecodedLen(len(src)))
+            count, err := enc.Decode(dbuf, []byte(src))
+            testEqual(t, "Decode(%q) = error %v, want %v", src, err, error(nil))
+            testEqual(t, "Decode(%q) = %q, want %q", src, string(dbuf[:count]), p.decoded)
+
+            /* with CRLF line breaks, and without the terminating line */
+            for _, in := range []string{strings.ReplaceAll(src, "\n", "\r\n"), src[:len(src) - 2]} {
+                dec, err := enc.DecodeString(in)
+                testEqual(t, "DecodeString(%q) = error %v, want %v", in, err, error(nil))
+                testEqual(t, "DecodeString(%q) = %q, want %q", in, string(dec), p.decoded)
+            }
+        }
+    }
+
+    /* spaces for zeros, and ignored trailing characters */
+    dec, err := StdEncoding.DecodeString("!0P  M\n \n")
+    testEqual(t, "DecodeString() = error %v, want %v", err, error(nil))
+    testEqual(t, "DecodeString() = %q, want %q", string(dec), "C")
+}
+
+func TestDecoderError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        pos int
+    }{
+        {"\n", 0},
+        {"#0V%\n", 4},
+        {"#0V%T\n\n", 6},
+        {"#0V%T\n#0V~T\n", 9},
+        {"#0V%T\n`\n#0V%T\n", 8},
+        {"a0V%T\n", 0},
+    } {
+        _, err := StdEncoding.DecodeString(tt.src)
+        testEqual(t, "DecodeString(%q) = error %v, want %v", tt.src, err, error(CorruptInputError(tt.pos)))
+    }
+    _, err := XXEncoding.DecodeString("1Eq`o\n")
+    testEqual(t, "DecodeString() = error %v, want %v", err, error(CorruptInputError(3)))
+}
+
+func TestRoundTrip(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for _, enc := range []Encoding{StdEncoding, XXEncoding} {
+        for n := 0; n < 256; n++ {
+            src := make([]byte, n)
+            rng.Read(src)
+            got := enc.EncodeToString(src)
+            testEqual(t, "EncodedLen(%d) = %d, want %d", n, enc.EncodedLen(n), len(got))
+            dec, err := enc.DecodeString(got)
+            if err != nil || !bytes.Equal(dec, src) {
+                t.Errorf("DecodeString(%q) = %x, %v, want %x", got, dec, err, src)
+            }
+        }
+    }
+}
+
+func TestFile(t *testing.T) {
+    doc, err := StdEncoding.EncodeFile(Header{Name: "cat.txt", Mode: 0600}, []byte("Cat"))
+    testEqual(t, "EncodeFile() = error %v, want %v", err, error(nil))
+    testEqual(t, "EncodeFile() = %q, want %q", string(doc), "begin 600 cat.txt\n#0V%T\n`\nend\n")
+
+    /* default mode */
+    doc, _ = StdEncoding.EncodeFile(Header{Name: "a b"}, nil)
+    testEqual(t, "EncodeFile() = %q, want %q", string(doc), "begin 644 a b\n`\nend\n")
+    doc, _ = StdEncoding.EncodeFile(Header{Name: "x", Mode: 0755}, nil)
+    testEqual(t, "EncodeFile() = %q, want %q", string(doc), "begin 755 x\n`\nend\n")
+    doc, _ = StdEncoding.EncodeFile(Header{Name: "x", Mode: 07}, nil)
+    testEqual(t, "EncodeFile() = %q, want %q", string(doc), "begin 007 x\n`\nend\n")
+
+    /* invalid names */
+    for _, name := range []string{"", "a\nb"} {
+        _, err = StdEncoding.EncodeFile(Header{Name: name}, nil)
+        testEqual(t, "EncodeFile(%q) = error %v, want %v", name, err, ErrHeader)
+    }
+
+    /* embedded in a mail message */
+    hdr, data, err := StdEncoding.DecodeFile([]byte("Subject: hi\r\n\r\nbegin 0640 my file\r\n#0V%T\r\n`\r\nend\r\n\r\n-- \r\n"))
+    testEqual(t, "DecodeFile() = error %v, want %v", err, error(nil))
+    testEqual(t, "DecodeFile() = %v, want %v", hdr, Header{Name: "my file", Mode: 0640})
+    testEqual(t, "DecodeFile() = %q, want %q", string(data), "Cat")
+
+    /* round-trip with xxencode */
+    src := make([]byte, 1000)
+    rand.New(rand.NewSource(0)).Read(src)
+    doc, _ = XXEncoding.EncodeFile(Header{Name: "data.bin"}, src)
+    hdr, data, err = XXEncoding.DecodeFile(doc)
+    if err != nil || hdr.Name != "data.bin" || hdr.Mode != 0644 || !bytes.Equal(data, src) {
+        t.Errorf("DecodeFile() = %v, %v", hdr, err)
+    }
+}
+
+func TestFileError(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        err error
+    }{
+        {"", ErrHeader},
+        {"#0V%T\n`\nend\n", ErrHeader},
+        {"begin 999 x\n`\nend\n", ErrHeader},
+        {"begin 644\n`\nend\n", ErrHeader},
+        {"begin 644 x\n#0V%T\n", io.ErrUnexpectedEOF},
+        {"begin 644 x\n#0V%T\n`\n", ErrTrailer},
+        {"begin 644 x\n#0V%T\n`\nbegin\n", ErrTrailer},
+        {"begin 644 x\n#0V%T\n#0V\n`\nend\n", CorruptInputError(21)},
+    } {
+        _, _, err := StdEncoding.DecodeFile([]byte(tt.src))
+        testEqual(t, "DecodeFile(%q) = error %v, want %v", tt.src, err, tt.err)
+    }
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `encoding/xml`
+
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+// XMLBytes is a []byte that is marshaled into XML elements and
+// attributes as xs:base64Binary, with StdEncoding.
+//
+// The values are decoded with DecodeXML, so XML whitespace may appear
+// anywhere, and invalid base64 data is reported as
+// base64.CorruptInputError, with the offset relative to the unescaped
+// text of the element or attribute.
+type XMLBytes []byte
+
+// MarshalXML implements xml.Marshaler.
+func (self XMLBytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+    return e.EncodeElement(StdEncoding.EncodeToString(self), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (self *XMLBytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+    var text []byte
+    if err := d.DecodeElement(&text, &start); err != nil {
+        return err
+    } else {
+        return unmarshalXML((*[]byte)(self), text)
+    }
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr.
+func (self XMLBytes) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+    return xml.Attr{Name: name, Value: StdEncoding.EncodeToString(self)}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr.
+func (self *XMLBytes) UnmarshalXMLAttr(attr xml.Attr) error {
+    return unmarshalXML((*[]byte)(self), rt.Str2Mem(attr.Value))
+}
+
+func unmarshalXML(out *[]byte, text []byte) error {
+    if ret, err := StdEncoding.DecodeXML(text); err != nil {
+        return err
+    } else {
+        *out = ret
+        return nil
+    }
+}
+
+// DecodeXML decodes src, which is an xs:base64Binary value, and returns
+// the decoded data. XML whitespace, that is space, tab, CR and LF, is
+// accepted anywhere, as the "collapse" whitespace facet of the type
+// permits.
+//
+// The new lines are skipped by the decoder itself, and inputs with
+// spaces or tabs are copied without them into a temporary buffer,
+// which is then decoded in place. Otherwise the buffer takes DecodedCap
+// bytes, which leaves room for a partial padding at the end, such as
+// "Zg=". The offset in a base64.CorruptInputError is relative to the
+// beginning of src.
+func (self Encoding) DecodeXML(src []byte) ([]byte, error) {
+    if bytes.IndexByte(src, ' ') < 0 && bytes.IndexByte(src, '\t') < 0 {
+        ret := make([]byte, 0, self.DecodedCap(len(src)))
+        if _, err := self.DecodeUnsafe(&ret, src); err != nil {
+            return nil, err
+        } else {
+            return ret, nil
+        }
+    }
+
+    /* collapse the blanks */
+    buf := make([]byte, 0, len(src))
+    for _, c := range src {
+        if c != ' ' && c != '\t' {
+            buf = append(buf, c)
+        }
+    }
+
+    /* the offsets skip the blanks */
+    if ret, err := self.DecodeInPlace(buf); err != nil {
+        return nil, base64.CorruptInputError(indexNonBlank(src, int(err.(base64.CorruptInputError))))
+    } else {
+        return ret, nil
+    }
+}
+
+// indexNonBlank returns the offset in src of the n-th character that is
+// not a space or a tab, or len(src) if there are not that many.
+func indexNonBlank(src []byte, n int) int {
+    for i, c := range src {
+        if c == ' ' || c == '\t' {
+            continue
+        } else if n == 0 {
+            return i
+        } else {
+            n--
+        }
+    }
+    return len(src)
+}
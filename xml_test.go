@@ -0,0 +1,125 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package base64x
+
+import (
+    `bytes`
+    `encoding/base64`
+    `encoding/xml`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+type xmlValue struct {
+    XMLName xml.Name `xml:"Value"`
+    ID      XMLBytes `xml:"id,attr,omitempty"`
+    Data    XMLBytes `xml:"Data"`
+    Extra   XMLBytes `xml:"Extra,omitempty"`
+}
+
+func TestXMLBytes(t *testing.T) {
+    in := xmlValue{ID: XMLBytes("id"), Data: XMLBytes("foobar")}
+    buf, err := xml.Marshal(in)
+    testEqual(t, "Marshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "Marshal() = %s, want %s", string(buf), `<Value id="aWQ="><Data>Zm9vYmFy</Data></Value>`)
+
+    /* round trip */
+    var out xmlValue
+    err = xml.Unmarshal(buf, &out)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "ID = %q, want %q", string(out.ID), "id")
+    testEqual(t, "Data = %q, want %q", string(out.Data), "foobar")
+    testEqual(t, "Extra = %v, want %v", out.Extra == nil, true)
+}
+
+func TestXMLBytesWhitespace(t *testing.T) {
+    src := `<Value id=" a W Q = ">
+        <Data>
+            Zm9v&#x9;YmFy
+            Zm9v YmE=
+        </Data>
+        <Extra>Zm 9v&#13;&#10;Y g==</Extra>
+    </Value>`
+
+    /* the whitespace is collapsed */
+    var out xmlValue
+    err := xml.Unmarshal([]byte(src), &out)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(nil))
+    testEqual(t, "ID = %q, want %q", string(out.ID), "id")
+    testEqual(t, "Data = %q, want %q", string(out.Data), "foobarfooba")
+    testEqual(t, "Extra = %q, want %q", string(out.Extra), "foob")
+
+    /* the offsets are relative to the text */
+    err = xml.Unmarshal([]byte("<Value><Data>\n  Zm9v Y!Fy\n</Data></Value>"), &out)
+    testEqual(t, "Unmarshal() = error %v, want %v", err, error(base64.CorruptInputError(9)))
+}
+
+func TestDecodeXML(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 300; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* wrapped and indented, as in XML-DSig */
+        enc := StdEncoding.EncodeToString(src)
+        var sb strings.Builder
+        for i := 0; i < len(enc); i += 76 {
+            sb.WriteString("\r\n\t ")
+            sb.WriteString(enc[i:minInt(i + 76, len(enc))])
+        }
+        sb.WriteString("\n")
+
+        /* decode it back */
+        dec, err := StdEncoding.DecodeXML([]byte(sb.String()))
+        if err != nil || !bytes.Equal(dec, src) {
+            t.Fatalf("DecodeXML(%q) = %x, %v, want %x", sb.String(), dec, err, src)
+        }
+    }
+
+    /* with and without blanks */
+    for _, tt := range []struct {
+        src string
+        out string
+        pos int
+    }{
+        {"Zm9vYmFy", "foobar", -1},
+        {"Zm9v\r\nYmFy", "foobar", -1},
+        {" Z m 9 v \t Y m F y ", "foobar", -1},
+        {" Z g = = ", "f", -1},
+        {"Zm!vYmFy", "", 2},
+        {"\tZ m ! v Y m F y", "", 5},
+        {"Zm9v\r\n  Y!Fy", "", 9},
+    } {
+        var want error
+        if tt.pos >= 0 {
+            want = base64.CorruptInputError(tt.pos)
+        }
+        dec, err := StdEncoding.DecodeXML([]byte(tt.src))
+        testEqual(t, "DecodeXML(%q) = error %v, want %v", tt.src, err, want)
+        testEqual(t, "DecodeXML(%q) = %q, want %q", tt.src, string(dec), tt.out)
+    }
+}
+
+func TestDecodeXMLShortPadding(t *testing.T) {
+    var v XMLBytes
+    err := v.UnmarshalXMLAttr(xml.Attr{Value: "Zg="})
+    if err != nil || string(v) != "f" || cap(v) < 3 {
+        t.Errorf("UnmarshalXMLAttr() = %q (cap %d), %v", v, cap(v), err)
+    }
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+// Package yamlbinary implements the YAML !!binary scalars, which hold
+// the base64 encoding of the data with base64x.StdEncoding, broken
+// across lines and indented like any other scalar.
+package yamlbinary
+
+import (
+    `encoding/base64`
+    `errors`
+
+    `github.com/cloudwego/base64x`
+    `github.com/cloudwego/base64x/internal/rt`
+)
+
+const (
+    _TAG      = "!!binary"
+    _LINE_LEN = 76
+    _LINE_RAW = _LINE_LEN / 4 * 3
+)
+
+// ErrSyntax is returned by Decode for scalars with malformed block
+// headers or unterminated quotes.
+var ErrSyntax = errors.New("yamlbinary: malformed !!binary scalar")
+
+// Decode decodes a !!binary scalar, written as in a YAML document and
+// optionally preceded by the "!!binary" tag. src holds the scalar only,
+// up to the end of its last line. It may be a literal or a
+// folded block scalar ("|" or ">", with the chomping and indentation
+// indicators), a single or double quoted scalar, or a plain scalar.
+//
+// Whitespace is not part of the base64 data, so the indentation, the
+// line breaks and the folded spaces are all ignored, as are the escaped
+// line breaks, spaces and tabs of double quoted scalars. The offset in a
+// base64.CorruptInputError is relative to the beginning of src.
+func Decode(src []byte) ([]byte, error) {
+    i := skipSpaces(src, 0)
+
+    /* the optional tag */
+    if len(src) - i >= len(_TAG) && string(src[i:i + len(_TAG)]) == _TAG {
+        if j := i + len(_TAG); j == len(src) || isSpace(src[j]) {
+            i = skipSpaces(src, j)
+        }
+    }
+
+    /* the scalar styles, plain scalars are taken as they are */
+    var err error
+    var ie = len(src)
+    var dq = i < len(src) && src[i] == '"'
+    if i < len(src) {
+        switch src[i] {
+            case '|', '>'  : i, err = skipHeader(src, i + 1)
+            case '"', '\'' : i, ie, err = quoted(src, i)
+        }
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    /* strip the whitespace into a new buffer */
+    body := src[i:ie]
+    buf := make([]byte, 0, len(body))
+    for j := skip(body, 0, dq); j < len(body); j = skip(body, j + 1, dq) {
+        buf = append(buf, body[j])
+    }
+
+    /* decode it in place */
+    ret, err := base64x.StdEncoding.DecodeInPlace(buf)
+    if err != nil {
+        return nil, base64.CorruptInputError(i + offsetOf(body, int(err.(base64.CorruptInputError)), dq))
+    } else {
+        return ret, nil
+    }
+}
+
+// AppendBlock appends the !!binary tag and the literal block scalar of
+// src to dst, for a value whose lines are indented by indent spaces.
+// The lines hold 76 characters, as in MIME, and the line break after the
+// last one is included. The empty data is written as a quoted scalar,
+// since a block scalar needs at least one line.
+func AppendBlock(dst []byte, src []byte, indent int) []byte {
+    dst = append(dst, _TAG...)
+    if len(src) == 0 {
+        return append(dst, ` ""` + "\n"...)
+    }
+
+    /* the block header */
+    dst = rt.GrowSlice(dst, BlockLen(len(src), indent) - len(_TAG))
+    dst = append(dst, " |\n"...)
+
+    /* encode the lines */
+    for len(src) != 0 {
+        n := len(src)
+        if n > _LINE_RAW {
+            n = _LINE_RAW
+        }
+        for i := 0; i < indent; i++ {
+            dst = append(dst, ' ')
+        }
+        base64x.StdEncoding.EncodeUnsafe(&dst, src[:n])
+        dst = append(dst, '\n')
+        src = src[n:]
+    }
+    return dst
+}
+
+// BlockLen returns the length of the result of AppendBlock for n bytes
+// of data, and lines indented by indent spaces.
+func BlockLen(n int, indent int) int {
+    if n == 0 {
+        return len(_TAG) + 4
+    }
+    nl := (n + _LINE_RAW - 1) / _LINE_RAW
+    return len(_TAG) + 3 + base64x.StdEncoding.EncodedLen(n) + nl * (indent + 1)
+}
+
+// skipHeader skips the indicators and the comment of a block scalar
+// header, and returns the offset of the first content line.
+func skipHeader(src []byte, i int) (int, error) {
+    var chomp, indent bool
+    for ; i < len(src); i++ {
+        if c := src[i]; (c == '+' || c == '-') && !chomp {
+            chomp = true
+        } else if c >= '1' && c <= '9' && !indent {
+            indent = true
+        } else {
+            break
+        }
+    }
+
+    /* comments must be separated by spaces */
+    if j := skipBlanks(src, i); j < len(src) && src[j] == '#' && j > i {
+        for i = j; i < len(src) && src[i] != '\n'; i++ {}
+    } else {
+        i = j
+    }
+
+    /* the header ends with a line break */
+    switch {
+        case i == len(src)  : return i, nil
+        case src[i] == '\r' : return i, nil
+        case src[i] == '\n' : return i, nil
+        default             : return 0, ErrSyntax
+    }
+}
+
+// quoted returns the bounds of the contents of the quoted scalar that
+// starts at src[i], which must only be followed by whitespace.
+func quoted(src []byte, i int) (int, int, error) {
+    q := src[i]
+    p := i + 1
+
+    /* find the closing quote */
+    for i = p; i < len(src); i++ {
+        if c := src[i]; c == '\\' && q == '"' {
+            i++
+        } else if c != q {
+            continue
+        } else if q == '\'' && i + 1 < len(src) && src[i + 1] == '\'' {
+            i++
+        } else if skipSpaces(src, i + 1) != len(src) {
+            return 0, 0, ErrSyntax
+        } else {
+            return p, i, nil
+        }
+    }
+    return 0, 0, ErrSyntax
+}
+
+// skip returns the offset of the first character of body at or after i
+// that is not whitespace, nor an escaped whitespace if dq is true.
+func skip(body []byte, i int, dq bool) int {
+    for ; i < len(body); i++ {
+        if c := body[i]; isSpace(c) {
+            continue
+        } else if !dq || c != '\\' || i + 1 == len(body) {
+            break
+        }
+
+        /* the escaped line breaks and blanks */
+        switch body[i + 1] {
+            case ' ', 't', '\t', '\r', '\n' : i++
+            default                        : return i
+        }
+    }
+    return i
+}
+
+// offsetOf returns the offset in body of the n-th character that is not
+// skipped, or the offset after the last one if there are not that many.
+func offsetOf(body []byte, n int, dq bool) int {
+    ret := 0
+    for j := skip(body, 0, dq); j < len(body); j = skip(body, j + 1, dq) {
+        if ret = j + 1; n == 0 {
+            return j
+        }
+        n--
+    }
+    return ret
+}
+
+func isSpace(c byte) bool {
+    return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func skipSpaces(src []byte, i int) int {
+    for i < len(src) && isSpace(src[i]) {
+        i++
+    }
+    return i
+}
+
+func skipBlanks(src []byte, i int) int {
+    for i < len(src) && (src[i] == ' ' || src[i] == '\t') {
+        i++
+    }
+    return i
+}
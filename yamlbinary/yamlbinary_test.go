@@ -0,0 +1,112 @@
+/*
+ * Copyright 2026 CloudWeGo Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+
+package yamlbinary
+
+import (
+    `bytes`
+    `encoding/base64`
+    `math/rand`
+    `strings`
+    `testing`
+)
+
+func TestDecode(t *testing.T) {
+    for _, tt := range []struct {
+        src  string
+        data string
+    }{
+        {"!!binary Zm9vYmFy", "foobar"},
+        {"Zm9vYmFy", "foobar"},
+        {"Zm9v\n  YmFy\n", "foobar"},
+        {"!!binary |\n  Zm9v\n  YmFy\n", "foobar"},
+        {"!!binary |-\n  Zm9v\n  YmFy", "foobar"},
+        {"!!binary >+2 # comment\n    Zm9v\r\n    YmFy\r\n\n", "foobar"},
+        {"|\n\tZm9v\n\n\tYmE=\n", "fooba"},
+        {`!!binary "Zm9v YmFy"`, "foobar"},
+        {"!!binary \"Zm9v\\\n  \\ Ym\\tFy\" \n", "foobar"},
+        {"!!binary 'Zm9v\n\n  YmFy'", "foobar"},
+        {"!!binary ''", ""},
+        {"!!binary", ""},
+        {"", ""},
+    } {
+        data, err := Decode([]byte(tt.src))
+        if err != nil || string(data) != tt.data {
+            t.Fatalf("Decode(%q) = %q, %v, want %q", tt.src, data, err, tt.data)
+        }
+    }
+}
+
+func TestDecodeErrors(t *testing.T) {
+    for _, tt := range []struct {
+        src string
+        err error
+    }{
+        {"!!binary | Zm9v", ErrSyntax},
+        {"!!binary |#\n  Zm9v", ErrSyntax},
+        {"!!binary |++\n  Zm9v", ErrSyntax},
+        {`!!binary "Zm9v`, ErrSyntax},
+        {`!!binary "Zm9v\"`, ErrSyntax},
+        {`!!binary 'Zm9v' x`, ErrSyntax},
+        {"!!binary |\n  Zm9v\n  Y!Fy\n", base64.CorruptInputError(21)},
+        {"!!binary\n  Zm 9v\n  Y!Fy\n", base64.CorruptInputError(20)},
+        {"!!binary \"Zm9v\\\n  \\YmFy\"", base64.CorruptInputError(18)},
+        {"!!binary 'Zm9v\n  Ym ''Fy'", base64.CorruptInputError(20)},
+    } {
+        if _, err := Decode([]byte(tt.src)); err != tt.err {
+            t.Fatalf("Decode(%q) = error %v, want %v", tt.src, err, tt.err)
+        }
+    }
+}
+
+func TestAppendBlock(t *testing.T) {
+    rng := rand.New(rand.NewSource(0))
+    for n := 0; n < 300; n++ {
+        src := make([]byte, n)
+        rng.Read(src)
+
+        /* indented lines of 76 characters at most */
+        for _, indent := range []int{0, 2, 4} {
+            buf := AppendBlock([]byte("key: "), src, indent)
+            if len(buf) != 5 + BlockLen(n, indent) {
+                t.Fatalf("AppendBlock(%d, %d) = %d bytes, want %d", n, indent, len(buf) - 5, BlockLen(n, indent))
+            }
+            lines := strings.Split(string(buf), "\n")
+            for _, line := range lines[1:len(lines) - 1] {
+                if len(line) > indent + 76 || strings.TrimLeft(line, " ") != line[indent:] {
+                    t.Fatalf("AppendBlock(%d, %d) = bad line %q", n, indent, line)
+                }
+            }
+
+            /* decode it back */
+            data, err := Decode(buf[5:])
+            if err != nil || !bytes.Equal(data, src) {
+                t.Fatalf("Decode(%q) = %x, %v, want %x", buf[5:], data, err, src)
+            }
+        }
+    }
+
+    /* the exact form */
+    buf := AppendBlock(nil, bytes.Repeat([]byte("x"), 60), 2)
+    want := "!!binary |\n  " + strings.Repeat("eHh4", 19) + "\n  eHh4\n"
+    if string(buf) != want {
+        t.Fatalf("AppendBlock() = %q, want %q", buf, want)
+    }
+    if buf = AppendBlock(nil, nil, 2); string(buf) != "!!binary \"\"\n" {
+        t.Fatalf("AppendBlock() = %q, want %q", buf, "!!binary \"\"\n")
+    }
+}